@@ -0,0 +1,334 @@
+package v1alpha1
+
+import (
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/mapyr/mcp-hangar/operator/api/v1beta1"
+)
+
+// ConvertTo converts this v1alpha1 MCPDiscoverySource to the v1beta1 hub
+// version. RefreshInterval and LastSyncDuration, stringly-typed here, parse
+// into metav1.Duration on the hub - a malformed or empty string falls back
+// to the same default RefreshInterval's own kubebuilder default already
+// implies (1m), and to zero for LastSyncDuration, which is report-only.
+//
+// ProviderTemplate.Spec converts only the fields v1beta1.MCPProviderSpec
+// mirrors; see that type's doc comment for the documented-lossy rest.
+func (src *MCPDiscoverySource) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta1.MCPDiscoverySource)
+
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec.Type = v1beta1.DiscoveryType(src.Spec.Type)
+	dst.Spec.Mode = v1beta1.DiscoveryMode(src.Spec.Mode)
+	dst.Spec.RefreshInterval = parseDuration(src.Spec.RefreshInterval, time.Minute)
+	dst.Spec.Paused = src.Spec.Paused
+	dst.Spec.PreserveFields = src.Spec.PreserveFields
+	dst.Spec.DriftPolicy = v1beta1.DriftPolicy(src.Spec.DriftPolicy)
+
+	if ns := src.Spec.NamespaceSelector; ns != nil {
+		dst.Spec.NamespaceSelector = &v1beta1.NamespaceSelectorConfig{
+			MatchLabels:       ns.MatchLabels,
+			MatchExpressions:  ns.MatchExpressions,
+			ExcludeNamespaces: ns.ExcludeNamespaces,
+		}
+	}
+
+	if cm := src.Spec.ConfigMapRef; cm != nil {
+		dst.Spec.ConfigMapRef = &v1beta1.ConfigMapReference{Name: cm.Name, Namespace: cm.Namespace, Key: cm.Key}
+	}
+
+	if ann := src.Spec.Annotations; ann != nil {
+		dst.Spec.Annotations = &v1beta1.AnnotationDiscoveryConfig{
+			PodSelector:         ann.PodSelector,
+			ServiceSelector:     ann.ServiceSelector,
+			AnnotationPrefix:    ann.AnnotationPrefix,
+			RequiredAnnotations: ann.RequiredAnnotations,
+		}
+	}
+
+	if sd := src.Spec.ServiceDiscovery; sd != nil {
+		dst.Spec.ServiceDiscovery = convertServiceDiscoveryTo(sd)
+	}
+
+	if pt := src.Spec.ProviderTemplate; pt != nil {
+		dst.Spec.ProviderTemplate = &v1beta1.ProviderTemplateConfig{
+			RelabelConfigs: convertRelabelConfigsTo(pt.RelabelConfigs),
+		}
+		if pt.Metadata != nil {
+			dst.Spec.ProviderTemplate.Metadata = &v1beta1.TemplateMetadata{
+				Labels:      pt.Metadata.Labels,
+				Annotations: pt.Metadata.Annotations,
+			}
+		}
+		if pt.Spec != nil {
+			dst.Spec.ProviderTemplate.Spec = &v1beta1.MCPProviderSpec{
+				Mode:       string(pt.Spec.Mode),
+				Image:      pt.Spec.Image,
+				Command:    pt.Spec.Command,
+				Args:       pt.Spec.Args,
+				WorkingDir: pt.Spec.WorkingDir,
+				Endpoint:   pt.Spec.Endpoint,
+				Replicas:   pt.Spec.Replicas,
+			}
+		}
+	}
+
+	if f := src.Spec.Filters; f != nil {
+		dst.Spec.Filters = &v1beta1.DiscoveryFilters{
+			IncludePatterns: f.IncludePatterns,
+			ExcludePatterns: f.ExcludePatterns,
+			MaxProviders:    f.MaxProviders,
+		}
+	}
+
+	dst.Spec.RelabelConfigs = convertRelabelConfigsTo(src.Spec.RelabelConfigs)
+
+	if o := src.Spec.Ownership; o != nil {
+		dst.Spec.Ownership = &v1beta1.OwnershipConfig{Controller: o.Controller, BlockDeletion: o.BlockDeletion}
+	}
+
+	dst.Status.DiscoveredCount = src.Status.DiscoveredCount
+	dst.Status.ManagedCount = src.Status.ManagedCount
+	dst.Status.DriftedCount = src.Status.DriftedCount
+	dst.Status.LastSyncTime = src.Status.LastSyncTime
+	dst.Status.LastSyncDuration = parseDuration(src.Status.LastSyncDuration, 0)
+	dst.Status.LastSyncError = src.Status.LastSyncError
+	dst.Status.NextSyncTime = src.Status.NextSyncTime
+	dst.Status.ObservedGeneration = src.Status.ObservedGeneration
+
+	for _, p := range src.Status.DiscoveredProviders {
+		dp := v1beta1.DiscoveredProvider{
+			Name: p.Name, Source: p.Source, DiscoveredAt: p.DiscoveredAt, Managed: p.Managed, Error: p.Error,
+		}
+		if p.Topology != nil {
+			dp.Topology = &v1beta1.EndpointTopology{Zone: p.Topology.Zone, NodeName: p.Topology.NodeName}
+		}
+		dst.Status.DiscoveredProviders = append(dst.Status.DiscoveredProviders, dp)
+	}
+
+	for _, d := range src.Status.DriftedProviders {
+		dst.Status.DriftedProviders = append(dst.Status.DriftedProviders, v1beta1.DriftedProvider{
+			Name: d.Name, Namespace: d.Namespace, Fields: d.Fields, FirstObservedAt: d.FirstObservedAt,
+		})
+	}
+
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, v1beta1.Condition{
+			Type:               c.Type,
+			Status:             c.Status,
+			LastTransitionTime: c.LastTransitionTime,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			ObservedGeneration: c.ObservedGeneration,
+		})
+	}
+
+	return nil
+}
+
+// ConvertFrom converts the v1beta1 hub version into this v1alpha1
+// MCPDiscoverySource. RefreshInterval/LastSyncDuration render back to Go
+// duration strings via Duration.String().
+func (dst *MCPDiscoverySource) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.MCPDiscoverySource)
+
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec.Type = DiscoveryType(src.Spec.Type)
+	dst.Spec.Mode = DiscoveryMode(src.Spec.Mode)
+	dst.Spec.RefreshInterval = src.Spec.RefreshInterval.Duration.String()
+	dst.Spec.Paused = src.Spec.Paused
+	dst.Spec.PreserveFields = src.Spec.PreserveFields
+	dst.Spec.DriftPolicy = DriftPolicy(src.Spec.DriftPolicy)
+
+	if ns := src.Spec.NamespaceSelector; ns != nil {
+		dst.Spec.NamespaceSelector = &NamespaceSelectorConfig{
+			MatchLabels:       ns.MatchLabels,
+			MatchExpressions:  ns.MatchExpressions,
+			ExcludeNamespaces: ns.ExcludeNamespaces,
+		}
+	}
+
+	if cm := src.Spec.ConfigMapRef; cm != nil {
+		dst.Spec.ConfigMapRef = &ConfigMapReference{Name: cm.Name, Namespace: cm.Namespace, Key: cm.Key}
+	}
+
+	if ann := src.Spec.Annotations; ann != nil {
+		dst.Spec.Annotations = &AnnotationDiscoveryConfig{
+			PodSelector:         ann.PodSelector,
+			ServiceSelector:     ann.ServiceSelector,
+			AnnotationPrefix:    ann.AnnotationPrefix,
+			RequiredAnnotations: ann.RequiredAnnotations,
+		}
+	}
+
+	if sd := src.Spec.ServiceDiscovery; sd != nil {
+		dst.Spec.ServiceDiscovery = convertServiceDiscoveryFrom(sd)
+	}
+
+	if pt := src.Spec.ProviderTemplate; pt != nil {
+		dst.Spec.ProviderTemplate = &ProviderTemplateConfig{
+			RelabelConfigs: convertRelabelConfigsFrom(pt.RelabelConfigs),
+		}
+		if pt.Metadata != nil {
+			dst.Spec.ProviderTemplate.Metadata = &TemplateMetadata{
+				Labels:      pt.Metadata.Labels,
+				Annotations: pt.Metadata.Annotations,
+			}
+		}
+		if pt.Spec != nil {
+			dst.Spec.ProviderTemplate.Spec = &MCPProviderSpec{
+				Mode:       ProviderMode(pt.Spec.Mode),
+				Image:      pt.Spec.Image,
+				Command:    pt.Spec.Command,
+				Args:       pt.Spec.Args,
+				WorkingDir: pt.Spec.WorkingDir,
+				Endpoint:   pt.Spec.Endpoint,
+				Replicas:   pt.Spec.Replicas,
+			}
+		}
+	}
+
+	if f := src.Spec.Filters; f != nil {
+		dst.Spec.Filters = &DiscoveryFilters{
+			IncludePatterns: f.IncludePatterns,
+			ExcludePatterns: f.ExcludePatterns,
+			MaxProviders:    f.MaxProviders,
+		}
+	}
+
+	dst.Spec.RelabelConfigs = convertRelabelConfigsFrom(src.Spec.RelabelConfigs)
+
+	if o := src.Spec.Ownership; o != nil {
+		dst.Spec.Ownership = &OwnershipConfig{Controller: o.Controller, BlockDeletion: o.BlockDeletion}
+	}
+
+	dst.Status.DiscoveredCount = src.Status.DiscoveredCount
+	dst.Status.ManagedCount = src.Status.ManagedCount
+	dst.Status.DriftedCount = src.Status.DriftedCount
+	dst.Status.LastSyncTime = src.Status.LastSyncTime
+	dst.Status.LastSyncDuration = src.Status.LastSyncDuration.Duration.String()
+	dst.Status.LastSyncError = src.Status.LastSyncError
+	dst.Status.NextSyncTime = src.Status.NextSyncTime
+	dst.Status.ObservedGeneration = src.Status.ObservedGeneration
+
+	for _, p := range src.Status.DiscoveredProviders {
+		dp := DiscoveredProvider{
+			Name: p.Name, Source: p.Source, DiscoveredAt: p.DiscoveredAt, Managed: p.Managed, Error: p.Error,
+		}
+		if p.Topology != nil {
+			dp.Topology = &EndpointTopology{Zone: p.Topology.Zone, NodeName: p.Topology.NodeName}
+		}
+		dst.Status.DiscoveredProviders = append(dst.Status.DiscoveredProviders, dp)
+	}
+
+	for _, d := range src.Status.DriftedProviders {
+		dst.Status.DriftedProviders = append(dst.Status.DriftedProviders, DriftedProvider{
+			Name: d.Name, Namespace: d.Namespace, Fields: d.Fields, FirstObservedAt: d.FirstObservedAt,
+		})
+	}
+
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, Condition{
+			Type:               c.Type,
+			Status:             c.Status,
+			LastTransitionTime: c.LastTransitionTime,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			ObservedGeneration: c.ObservedGeneration,
+		})
+	}
+
+	return nil
+}
+
+func convertServiceDiscoveryTo(sd *ServiceDiscoveryConfig) *v1beta1.ServiceDiscoveryConfig {
+	out := &v1beta1.ServiceDiscoveryConfig{
+		Backend:       v1beta1.DiscoveryBackend(sd.Backend),
+		Selector:      sd.Selector,
+		PortName:      sd.PortName,
+		Protocol:      sd.Protocol,
+		EndpointsMode: v1beta1.EndpointsMode(sd.EndpointsMode),
+	}
+	if sd.Consul != nil {
+		out.Consul = &v1beta1.ConsulDiscoveryConfig{
+			Address: sd.Consul.Address, Datacenter: sd.Consul.Datacenter,
+			ServiceName: sd.Consul.ServiceName, Tags: sd.Consul.Tags, Watch: sd.Consul.Watch,
+		}
+		if sd.Consul.ACLTokenSecretRef != nil {
+			out.Consul.ACLTokenSecretRef = &v1beta1.SecretKeySelector{
+				Name: sd.Consul.ACLTokenSecretRef.Name, Key: sd.Consul.ACLTokenSecretRef.Key, Optional: sd.Consul.ACLTokenSecretRef.Optional,
+			}
+		}
+	}
+	if sd.DNS != nil {
+		out.DNS = &v1beta1.DNSDiscoveryConfig{
+			RecordName: sd.DNS.RecordName, FallbackToHostRecords: sd.DNS.FallbackToHostRecords, Port: sd.DNS.Port,
+		}
+	}
+	if sd.MDNS != nil {
+		out.MDNS = &v1beta1.MDNSDiscoveryConfig{ServiceType: sd.MDNS.ServiceType, Domain: sd.MDNS.Domain}
+	}
+	return out
+}
+
+func convertServiceDiscoveryFrom(sd *v1beta1.ServiceDiscoveryConfig) *ServiceDiscoveryConfig {
+	out := &ServiceDiscoveryConfig{
+		Backend:       DiscoveryBackend(sd.Backend),
+		Selector:      sd.Selector,
+		PortName:      sd.PortName,
+		Protocol:      sd.Protocol,
+		EndpointsMode: EndpointsMode(sd.EndpointsMode),
+	}
+	if sd.Consul != nil {
+		out.Consul = &ConsulDiscoveryConfig{
+			Address: sd.Consul.Address, Datacenter: sd.Consul.Datacenter,
+			ServiceName: sd.Consul.ServiceName, Tags: sd.Consul.Tags, Watch: sd.Consul.Watch,
+		}
+		if sd.Consul.ACLTokenSecretRef != nil {
+			out.Consul.ACLTokenSecretRef = &SecretKeySelector{
+				Name: sd.Consul.ACLTokenSecretRef.Name, Key: sd.Consul.ACLTokenSecretRef.Key, Optional: sd.Consul.ACLTokenSecretRef.Optional,
+			}
+		}
+	}
+	if sd.DNS != nil {
+		out.DNS = &DNSDiscoveryConfig{
+			RecordName: sd.DNS.RecordName, FallbackToHostRecords: sd.DNS.FallbackToHostRecords, Port: sd.DNS.Port,
+		}
+	}
+	if sd.MDNS != nil {
+		out.MDNS = &MDNSDiscoveryConfig{ServiceType: sd.MDNS.ServiceType, Domain: sd.MDNS.Domain}
+	}
+	return out
+}
+
+func convertRelabelConfigsTo(cfgs []RelabelConfig) []v1beta1.RelabelConfig {
+	if cfgs == nil {
+		return nil
+	}
+	out := make([]v1beta1.RelabelConfig, len(cfgs))
+	for i, c := range cfgs {
+		out[i] = v1beta1.RelabelConfig{
+			SourceLabels: c.SourceLabels, Separator: c.Separator, Regex: c.Regex, Modulus: c.Modulus,
+			TargetLabel: c.TargetLabel, Replacement: c.Replacement, Action: v1beta1.RelabelAction(c.Action),
+		}
+	}
+	return out
+}
+
+func convertRelabelConfigsFrom(cfgs []v1beta1.RelabelConfig) []RelabelConfig {
+	if cfgs == nil {
+		return nil
+	}
+	out := make([]RelabelConfig, len(cfgs))
+	for i, c := range cfgs {
+		out[i] = RelabelConfig{
+			SourceLabels: c.SourceLabels, Separator: c.Separator, Regex: c.Regex, Modulus: c.Modulus,
+			TargetLabel: c.TargetLabel, Replacement: c.Replacement, Action: RelabelAction(c.Action),
+		}
+	}
+	return out
+}
@@ -0,0 +1,170 @@
+package v1alpha1
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mapyr/mcp-hangar/operator/api/v1beta1"
+)
+
+func TestConvertTo_DurationsParsed(t *testing.T) {
+	src := &MCPDiscoverySource{
+		Spec:   MCPDiscoverySourceSpec{RefreshInterval: "30s"},
+		Status: MCPDiscoverySourceStatus{LastSyncDuration: "2s"},
+	}
+
+	dst := &v1beta1.MCPDiscoverySource{}
+	require.NoError(t, src.ConvertTo(dst))
+
+	assert.Equal(t, 30*time.Second, dst.Spec.RefreshInterval.Duration)
+	assert.Equal(t, 2*time.Second, dst.Status.LastSyncDuration.Duration)
+}
+
+func TestConvertTo_MalformedRefreshIntervalFallsBackToOneMinute(t *testing.T) {
+	src := &MCPDiscoverySource{Spec: MCPDiscoverySourceSpec{RefreshInterval: "not-a-duration"}}
+
+	dst := &v1beta1.MCPDiscoverySource{}
+	require.NoError(t, src.ConvertTo(dst))
+
+	assert.Equal(t, time.Minute, dst.Spec.RefreshInterval.Duration)
+}
+
+func TestConvertFrom_DurationsRenderedAsStrings(t *testing.T) {
+	hub := &v1beta1.MCPDiscoverySource{
+		Spec:   v1beta1.MCPDiscoverySourceSpec{RefreshInterval: metav1.Duration{Duration: 45 * time.Second}},
+		Status: v1beta1.MCPDiscoverySourceStatus{LastSyncDuration: metav1.Duration{Duration: 3 * time.Second}},
+	}
+
+	dst := &MCPDiscoverySource{}
+	require.NoError(t, dst.ConvertFrom(hub))
+
+	assert.Equal(t, "45s", dst.Spec.RefreshInterval)
+	assert.Equal(t, "3s", dst.Status.LastSyncDuration)
+}
+
+func TestConvertTo_ServiceDiscoveryConsulBackendRoundTrips(t *testing.T) {
+	optional := true
+	src := &MCPDiscoverySource{Spec: MCPDiscoverySourceSpec{
+		ServiceDiscovery: &ServiceDiscoveryConfig{
+			Backend: DiscoveryBackendConsul,
+			Consul: &ConsulDiscoveryConfig{
+				Address:           "consul.service.consul:8500",
+				ServiceName:       "mcp-providers",
+				Tags:              []string{"prod"},
+				ACLTokenSecretRef: &SecretKeySelector{Name: "consul-acl", Key: "token", Optional: &optional},
+			},
+		},
+	}}
+
+	dst := &v1beta1.MCPDiscoverySource{}
+	require.NoError(t, src.ConvertTo(dst))
+
+	back := &MCPDiscoverySource{}
+	require.NoError(t, back.ConvertFrom(dst))
+
+	assert.Equal(t, src.Spec.ServiceDiscovery.Backend, back.Spec.ServiceDiscovery.Backend)
+	assert.Equal(t, src.Spec.ServiceDiscovery.Consul.Address, back.Spec.ServiceDiscovery.Consul.Address)
+	assert.Equal(t, src.Spec.ServiceDiscovery.Consul.Tags, back.Spec.ServiceDiscovery.Consul.Tags)
+	assert.Equal(t, *src.Spec.ServiceDiscovery.Consul.ACLTokenSecretRef.Optional, *back.Spec.ServiceDiscovery.Consul.ACLTokenSecretRef.Optional)
+}
+
+func TestConvertTo_RelabelConfigsRoundTrip(t *testing.T) {
+	src := &MCPDiscoverySource{Spec: MCPDiscoverySourceSpec{
+		RelabelConfigs: []RelabelConfig{
+			{SourceLabels: []string{"env"}, Regex: "prod", Action: RelabelKeep},
+			{TargetLabel: "shard", Modulus: 4, Action: RelabelHashmod},
+		},
+	}}
+
+	dst := &v1beta1.MCPDiscoverySource{}
+	require.NoError(t, src.ConvertTo(dst))
+
+	back := &MCPDiscoverySource{}
+	require.NoError(t, back.ConvertFrom(dst))
+
+	assert.Equal(t, src.Spec.RelabelConfigs, back.Spec.RelabelConfigs)
+}
+
+func TestConvertTo_DriftFieldsRoundTrip(t *testing.T) {
+	src := &MCPDiscoverySource{
+		Spec: MCPDiscoverySourceSpec{DriftPolicy: DriftPolicyReconcile, PreserveFields: []string{"spec.workingDir"}},
+		Status: MCPDiscoverySourceStatus{
+			DriftedCount: 1,
+			DriftedProviders: []DriftedProvider{
+				{Name: "p1", Namespace: "default", Fields: []string{"spec.image"}},
+			},
+		},
+	}
+
+	dst := &v1beta1.MCPDiscoverySource{}
+	require.NoError(t, src.ConvertTo(dst))
+
+	back := &MCPDiscoverySource{}
+	require.NoError(t, back.ConvertFrom(dst))
+
+	assert.Equal(t, src.Spec.DriftPolicy, back.Spec.DriftPolicy)
+	assert.Equal(t, src.Spec.PreserveFields, back.Spec.PreserveFields)
+	assert.Equal(t, src.Status.DriftedProviders, back.Status.DriftedProviders)
+}
+
+// TestConvertRoundTrip_Fuzz generates random MCPDiscoverySource specs and
+// checks ConvertTo -> ConvertFrom reproduces every field this conversion
+// claims to carry losslessly (everything except the stringly-typed
+// durations, which normalize format but not value, and the documented-lossy
+// ProviderTemplate.Spec subset).
+func TestConvertRoundTrip_Fuzz(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+
+	for i := 0; i < 50; i++ {
+		src := randomDiscoverySource(rng)
+
+		dst := &v1beta1.MCPDiscoverySource{}
+		require.NoError(t, src.ConvertTo(dst))
+
+		back := &MCPDiscoverySource{}
+		require.NoError(t, back.ConvertFrom(dst))
+
+		assert.Equal(t, src.Spec.Type, back.Spec.Type)
+		assert.Equal(t, src.Spec.Mode, back.Spec.Mode)
+		assert.Equal(t, src.Spec.DriftPolicy, back.Spec.DriftPolicy)
+		assert.Equal(t, src.Spec.PreserveFields, back.Spec.PreserveFields)
+		assert.Equal(t, src.Spec.RelabelConfigs, back.Spec.RelabelConfigs)
+		assert.Equal(t, src.Spec.Filters, back.Spec.Filters)
+		assert.Equal(t, src.Spec.Ownership, back.Spec.Ownership)
+		assert.Equal(t, src.Status.DriftedProviders, back.Status.DriftedProviders)
+	}
+}
+
+func randomDiscoverySource(rng *rand.Rand) *MCPDiscoverySource {
+	actions := []RelabelAction{RelabelReplace, RelabelKeep, RelabelDrop, RelabelHashmod}
+	policies := []DriftPolicy{DriftPolicyIgnore, DriftPolicyReport, DriftPolicyReconcile}
+	maxProviders := rng.Int31n(100)
+	controller := rng.Intn(2) == 0
+
+	return &MCPDiscoverySource{
+		Spec: MCPDiscoverySourceSpec{
+			Type:           DiscoveryTypeServiceDiscovery,
+			Mode:           DiscoveryModeAuthoritative,
+			DriftPolicy:    policies[rng.Intn(len(policies))],
+			PreserveFields: []string{"spec.workingDir", "spec.image"},
+			RelabelConfigs: []RelabelConfig{
+				{SourceLabels: []string{"env"}, Regex: "prod", Action: actions[rng.Intn(len(actions))]},
+			},
+			Filters: &DiscoveryFilters{
+				IncludePatterns: []string{"mcp-.*"},
+				MaxProviders:    &maxProviders,
+			},
+			Ownership: &OwnershipConfig{Controller: &controller, BlockDeletion: rng.Intn(2) == 0},
+		},
+		Status: MCPDiscoverySourceStatus{
+			DriftedProviders: []DriftedProvider{
+				{Name: "provider-" + string(rune('a'+rng.Intn(26))), Namespace: "default", Fields: []string{"spec.image"}},
+			},
+		},
+	}
+}
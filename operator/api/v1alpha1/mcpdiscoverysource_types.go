@@ -69,11 +69,64 @@ type MCPDiscoverySourceSpec struct {
 	// +optional
 	Filters *DiscoveryFilters `json:"filters,omitempty"`
 
+	// RelabelConfigs runs a Prometheus-inspired relabel pipeline over
+	// labels harvested from the discovery source (namespace/pod/service
+	// labels, annotations under AnnotationPrefix, endpoint topology) before
+	// a DiscoveredProvider is materialized. Rules run in order; keep/drop
+	// actions can remove a candidate from discovery entirely, alongside
+	// Filters' include/exclude patterns.
+	//
+	// EXPERIMENTAL (unwired): there is no MCPDiscoverySource reconciler in
+	// this tree yet to run this pipeline. pkg/discovery.Pipeline compiles
+	// and evaluates it, but nothing calls it against real discovery
+	// candidates - see pkg/discovery's package doc.
+	// +optional
+	RelabelConfigs []RelabelConfig `json:"relabelConfigs,omitempty"`
+
+	// DriftPolicy controls what happens when a managed MCPProvider no
+	// longer matches what ProviderTemplate + RelabelConfigs would produce.
+	//
+	// EXPERIMENTAL (unwired): there is no MCPDiscoverySource reconciler in
+	// this tree yet to run drift detection on a schedule.
+	// pkg/discovery.DiffSpec/ReconcileSpec implement the comparison and
+	// repair this policy configures, but nothing calls them - see
+	// pkg/discovery's package doc.
+	// +kubebuilder:default=Report
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// PreserveFields lists fieldpaths (dot-separated, e.g.
+	// "spec.container.resources") that DriftPolicyReconcile must leave
+	// alone even when they've drifted from the template, so hand-edited
+	// settings on a discovered MCPProvider aren't clobbered.
+	//
+	// EXPERIMENTAL (unwired): has no effect until a reconciler calls
+	// pkg/discovery.DiffSpec/ReconcileSpec - see DriftPolicy's doc comment.
+	// +optional
+	PreserveFields []string `json:"preserveFields,omitempty"`
+
 	// Ownership configures owner references
 	// +optional
 	Ownership *OwnershipConfig `json:"ownership,omitempty"`
 }
 
+// DriftPolicy controls how the discovery controller reacts when a managed
+// MCPProvider no longer matches what ProviderTemplate + RelabelConfigs
+// would currently produce for it.
+// +kubebuilder:validation:Enum=Ignore;Report;Reconcile
+type DriftPolicy string
+
+const (
+	// DriftPolicyIgnore never checks managed providers for drift.
+	DriftPolicyIgnore DriftPolicy = "Ignore"
+	// DriftPolicyReport records drifted providers on Status.DriftedProviders
+	// and the Drifted condition, but leaves them unmodified - the mode to
+	// run in to see what DriftPolicyReconcile would change before enabling it.
+	DriftPolicyReport DriftPolicy = "Report"
+	// DriftPolicyReconcile re-applies ProviderTemplate + RelabelConfigs to
+	// drifted providers, skipping any fieldpath listed in PreserveFields.
+	DriftPolicyReconcile DriftPolicy = "Reconcile"
+)
+
 // NamespaceSelectorConfig defines namespace selection
 type NamespaceSelectorConfig struct {
 	// MatchLabels selects namespaces with these labels
@@ -118,12 +171,70 @@ type AnnotationDiscoveryConfig struct {
 	RequiredAnnotations []string `json:"requiredAnnotations,omitempty"`
 }
 
+// EndpointsMode selects what ServiceDiscovery enumerates: whole Services,
+// or individual backend endpoints the way Prometheus's Kubernetes service
+// discovery exposes "endpoints"/"endpointslices" roles alongside "service".
+//
+// EXPERIMENTAL (unwired): there is no MCPDiscoverySource reconciler in this
+// tree yet to read this field. pkg/discovery.ResolveFromEndpoints and
+// ResolveFromEndpointSlices implement the enumeration logic it configures,
+// but nothing calls them - see pkg/discovery's package doc.
+// +kubebuilder:validation:Enum=Service;Endpoints;EndpointSlices
+type EndpointsMode string
+
+const (
+	// EndpointsModeService discovers one provider per Service, the
+	// original and still-default ServiceDiscovery behavior.
+	EndpointsModeService EndpointsMode = "Service"
+	// EndpointsModeEndpoints discovers one provider per ready backend
+	// address in the Service's Endpoints resource.
+	EndpointsModeEndpoints EndpointsMode = "Endpoints"
+	// EndpointsModeEndpointSlices discovers one provider per ready,
+	// serving, non-terminating backend address across the Service's
+	// EndpointSlices, including node/zone topology metadata EndpointSlice
+	// exposes that Endpoints doesn't.
+	EndpointsModeEndpointSlices EndpointsMode = "EndpointSlices"
+)
+
+// DiscoveryBackend selects the registry ServiceDiscovery resolves
+// providers from.
+//
+// EXPERIMENTAL (unwired): Consul/DNS/MDNS have no MCPDiscoverySource
+// reconciler in this tree to own their network calls yet.
+// pkg/discovery.ResolveFromConsul/ResolveFromDNSSRV/ResolveFromMDNS
+// implement the translation from already-fetched records to
+// DiscoveredProviders, but nothing fetches those records or calls them -
+// see pkg/discovery's package doc. Kubernetes remains the only backend a
+// running operator can actually use.
+// +kubebuilder:validation:Enum=Kubernetes;Consul;DNS;MDNS
+type DiscoveryBackend string
+
+const (
+	// DiscoveryBackendKubernetes discovers Services/Endpoints/EndpointSlices
+	// in-cluster, the original and still-default ServiceDiscovery backend.
+	DiscoveryBackendKubernetes DiscoveryBackend = "Kubernetes"
+	// DiscoveryBackendConsul discovers providers registered in a Consul
+	// catalog, for MCP servers running outside the cluster.
+	DiscoveryBackendConsul DiscoveryBackend = "Consul"
+	// DiscoveryBackendDNS discovers providers from DNS SRV records (with
+	// A/AAAA fallback when SRV isn't available).
+	DiscoveryBackendDNS DiscoveryBackend = "DNS"
+	// DiscoveryBackendMDNS discovers providers via multicast DNS
+	// service browsing.
+	DiscoveryBackendMDNS DiscoveryBackend = "MDNS"
+)
+
 // ServiceDiscoveryConfig configures service-based discovery
 type ServiceDiscoveryConfig struct {
-	// Selector selects Services to discover
+	// Backend selects the registry providers are discovered from.
+	// +kubebuilder:default=Kubernetes
+	Backend DiscoveryBackend `json:"backend,omitempty"`
+
+	// Selector selects Services to discover. Only used when Backend is Kubernetes.
 	Selector map[string]string `json:"selector,omitempty"`
 
-	// PortName is the port name to use for MCP endpoint
+	// PortName is the port name to use for MCP endpoint. Only used when
+	// Backend is Kubernetes.
 	// +kubebuilder:default="mcp"
 	PortName string `json:"portName,omitempty"`
 
@@ -131,6 +242,90 @@ type ServiceDiscoveryConfig struct {
 	// +kubebuilder:default=http
 	// +kubebuilder:validation:Enum=http;https
 	Protocol string `json:"protocol,omitempty"`
+
+	// EndpointsMode selects whether discovery resolves whole Services or
+	// enumerates their individual backend endpoints, producing one
+	// MCPProvider per healthy replica instead of per Service. Only used
+	// when Backend is Kubernetes.
+	// +kubebuilder:default=Service
+	EndpointsMode EndpointsMode `json:"endpointsMode,omitempty"`
+
+	// Consul configures discovery from a Consul service catalog. Required
+	// when Backend is Consul.
+	// +optional
+	Consul *ConsulDiscoveryConfig `json:"consul,omitempty"`
+
+	// DNS configures discovery from DNS SRV (or A/AAAA fallback) records.
+	// Required when Backend is DNS.
+	// +optional
+	DNS *DNSDiscoveryConfig `json:"dns,omitempty"`
+
+	// MDNS configures discovery via multicast DNS service browsing.
+	// Required when Backend is MDNS.
+	// +optional
+	MDNS *MDNSDiscoveryConfig `json:"mdns,omitempty"`
+}
+
+// ConsulDiscoveryConfig configures discovery from a Consul service catalog.
+type ConsulDiscoveryConfig struct {
+	// Address is the Consul HTTP API address, e.g. "consul.service.consul:8500".
+	// +kubebuilder:validation:Required
+	Address string `json:"address"`
+
+	// Datacenter restricts the catalog query to a single Consul datacenter.
+	// Defaults to the agent's own datacenter.
+	// +optional
+	Datacenter string `json:"datacenter,omitempty"`
+
+	// ACLTokenSecretRef names a Secret key holding the Consul ACL token
+	// used for catalog reads.
+	// +optional
+	ACLTokenSecretRef *SecretKeySelector `json:"aclTokenSecretRef,omitempty"`
+
+	// ServiceName filters the catalog to a single Consul service name.
+	// +kubebuilder:validation:Required
+	ServiceName string `json:"serviceName"`
+
+	// Tags filters catalog entries to those carrying all of these Consul
+	// service tags.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// Watch enables long-polling the catalog via Consul blocking queries
+	// instead of polling once per RefreshInterval.
+	// +kubebuilder:default=false
+	Watch bool `json:"watch,omitempty"`
+}
+
+// DNSDiscoveryConfig configures discovery from DNS SRV (or A/AAAA
+// fallback) records.
+type DNSDiscoveryConfig struct {
+	// RecordName is the SRV record to resolve, e.g.
+	// "_mcp._tcp.providers.example.com".
+	// +kubebuilder:validation:Required
+	RecordName string `json:"recordName"`
+
+	// FallbackToHostRecords resolves RecordName as A/AAAA when no SRV
+	// records are returned, using Port as the provider port since A/AAAA
+	// carries none.
+	// +kubebuilder:default=false
+	FallbackToHostRecords bool `json:"fallbackToHostRecords,omitempty"`
+
+	// Port is the provider port used for FallbackToHostRecords resolution.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+}
+
+// MDNSDiscoveryConfig configures discovery via multicast DNS service
+// browsing.
+type MDNSDiscoveryConfig struct {
+	// ServiceType is the mDNS service type to browse, e.g. "_mcp._tcp".
+	// +kubebuilder:validation:Required
+	ServiceType string `json:"serviceType"`
+
+	// Domain is the mDNS browse domain.
+	// +kubebuilder:default="local."
+	Domain string `json:"domain,omitempty"`
 }
 
 // ProviderTemplateConfig provides defaults for discovered providers
@@ -140,6 +335,84 @@ type ProviderTemplateConfig struct {
 
 	// Spec contains default MCPProvider spec fields
 	Spec *MCPProviderSpec `json:"spec,omitempty"`
+
+	// RelabelConfigs runs after Metadata/Spec defaulting, letting the
+	// template rewrite or drop a candidate based on its harvested labels.
+	// See MCPDiscoverySourceSpec.RelabelConfigs for the rule semantics.
+	// +optional
+	RelabelConfigs []RelabelConfig `json:"relabelConfigs,omitempty"`
+}
+
+// RelabelAction selects how a RelabelConfig rule applies once its source
+// labels and regex have been evaluated, mirroring Prometheus's relabel_config
+// actions.
+// +kubebuilder:validation:Enum=replace;keep;drop;hashmod;labelmap;labeldrop;labelkeep;lowercase;uppercase
+type RelabelAction string
+
+const (
+	// RelabelReplace sets TargetLabel to Replacement, with regex capture
+	// groups from the joined SourceLabels substituted in.
+	RelabelReplace RelabelAction = "replace"
+	// RelabelKeep drops the candidate unless the joined SourceLabels match Regex.
+	RelabelKeep RelabelAction = "keep"
+	// RelabelDrop drops the candidate if the joined SourceLabels match Regex.
+	RelabelDrop RelabelAction = "drop"
+	// RelabelHashmod sets TargetLabel to a hash of the joined SourceLabels
+	// modulo Modulus, for sharding discovered providers across a fixed
+	// number of buckets.
+	RelabelHashmod RelabelAction = "hashmod"
+	// RelabelLabelMap copies labels matching Regex to new label names
+	// derived from Replacement, applied as a regexp.ReplaceAllString on
+	// each matching label's name.
+	RelabelLabelMap RelabelAction = "labelmap"
+	// RelabelLabelDrop removes labels whose name matches Regex.
+	RelabelLabelDrop RelabelAction = "labeldrop"
+	// RelabelLabelKeep removes labels whose name does not match Regex.
+	RelabelLabelKeep RelabelAction = "labelkeep"
+	// RelabelLowercase lowercases the joined SourceLabels into TargetLabel.
+	RelabelLowercase RelabelAction = "lowercase"
+	// RelabelUppercase uppercases the joined SourceLabels into TargetLabel.
+	RelabelUppercase RelabelAction = "uppercase"
+)
+
+// RelabelConfig is one rule in a Prometheus-inspired relabel pipeline,
+// evaluated against labels harvested from a discovery candidate (namespace/
+// pod/service labels, annotations under AnnotationPrefix, endpoint
+// topology) before a DiscoveredProvider is materialized.
+type RelabelConfig struct {
+	// SourceLabels select label values to concatenate with Separator before
+	// Regex is evaluated against them. If empty, Regex is evaluated against
+	// the empty string.
+	// +optional
+	SourceLabels []string `json:"sourceLabels,omitempty"`
+
+	// Separator joins SourceLabels values before matching.
+	// +kubebuilder:default=";"
+	Separator string `json:"separator,omitempty"`
+
+	// Regex is matched against the joined SourceLabels. Unused by hashmod
+	// and the label* actions.
+	// +kubebuilder:default="(.*)"
+	Regex string `json:"regex,omitempty"`
+
+	// Modulus is the divisor for the hashmod action.
+	// +optional
+	Modulus uint64 `json:"modulus,omitempty"`
+
+	// TargetLabel is the label written by replace, hashmod, lowercase, and
+	// uppercase.
+	// +optional
+	TargetLabel string `json:"targetLabel,omitempty"`
+
+	// Replacement is substituted into TargetLabel for the replace action,
+	// with $1, $2, ... referring to Regex capture groups. For labelmap it
+	// is the replacement pattern applied to each matching label name.
+	// +kubebuilder:default="$1"
+	Replacement string `json:"replacement,omitempty"`
+
+	// Action selects how this rule applies.
+	// +kubebuilder:default=replace
+	Action RelabelAction `json:"action,omitempty"`
 }
 
 // TemplateMetadata defines template metadata
@@ -172,6 +445,40 @@ type OwnershipConfig struct {
 	BlockDeletion bool `json:"blockDeletion,omitempty"`
 }
 
+// Condition type names set on MCPDiscoverySourceStatus.Conditions by
+// external ServiceDiscovery backends, reporting whether the backend's
+// registry was reachable as of the last sync.
+//
+// EXPERIMENTAL (unwired): neither condition is ever set today - see
+// DiscoveryBackend's doc comment.
+const (
+	// ConditionConsulReachable reports whether the Consul agent at
+	// ConsulDiscoveryConfig.Address answered the last catalog query.
+	ConditionConsulReachable = "ConsulReachable"
+	// ConditionDNSResolvable reports whether DNSDiscoveryConfig.RecordName
+	// resolved on the last sync.
+	ConditionDNSResolvable = "DNSResolvable"
+	// ConditionDrifted reports whether any managed MCPProvider currently
+	// diverges from what ProviderTemplate + RelabelConfigs would produce,
+	// per Spec.DriftPolicy.
+	//
+	// EXPERIMENTAL (unwired): never set today - see Spec.DriftPolicy's doc
+	// comment.
+	ConditionDrifted = "Drifted"
+	// ConditionFeatureDisabled reports that Spec.Type (or the backend it
+	// selects, e.g. a Consul/DNS/MDNS ServiceDiscovery.Backend or the
+	// EndpointSlices EndpointsMode) is gated behind a featuregate.Gate that
+	// isn't enabled in the operator's active gate set. Reason and Message
+	// carry the gate name and the promotion level it requires.
+	//
+	// EXPERIMENTAL (unwired): never set today - there is no
+	// MCPDiscoverySource reconciler in this tree yet to call
+	// featuregate.CheckServiceDiscovery and set it. The gates themselves
+	// (the --feature-gates flag, /featuregates endpoint, and per-gate
+	// metric) are live; only this condition is not.
+	ConditionFeatureDisabled = "FeatureDisabled"
+)
+
 // MCPDiscoverySourceStatus defines the observed state of MCPDiscoverySource
 type MCPDiscoverySourceStatus struct {
 	// DiscoveredCount is the number of discovered providers
@@ -180,6 +487,13 @@ type MCPDiscoverySourceStatus struct {
 	// ManagedCount is the number of managed MCPProvider resources
 	ManagedCount int32 `json:"managedCount,omitempty"`
 
+	// DriftedCount is the number of entries in DriftedProviders, surfaced
+	// as its own field so it's cheap to print without scanning the list.
+	//
+	// EXPERIMENTAL (unwired): always 0 today - see Spec.DriftPolicy's doc
+	// comment.
+	DriftedCount int32 `json:"driftedCount,omitempty"`
+
 	// LastSyncTime is the last successful sync time
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
 
@@ -195,6 +509,16 @@ type MCPDiscoverySourceStatus struct {
 	// DiscoveredProviders lists discovered providers
 	DiscoveredProviders []DiscoveredProvider `json:"discoveredProviders,omitempty"`
 
+	// DriftedProviders lists managed MCPProviders currently diverging from
+	// what ProviderTemplate + RelabelConfigs would produce. Populated
+	// whenever Spec.DriftPolicy is Report or Reconcile; always empty under
+	// DriftPolicyIgnore.
+	//
+	// EXPERIMENTAL (unwired): always empty today - see Spec.DriftPolicy's
+	// doc comment.
+	// +optional
+	DriftedProviders []DriftedProvider `json:"driftedProviders,omitempty"`
+
 	// ObservedGeneration is the generation observed by controller
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 
@@ -202,12 +526,39 @@ type MCPDiscoverySourceStatus struct {
 	Conditions []Condition `json:"conditions,omitempty"`
 }
 
+// DriftedProvider records one managed MCPProvider whose live spec no
+// longer matches what ProviderTemplate + RelabelConfigs would currently
+// produce for it.
+type DriftedProvider struct {
+	// Name of the drifted MCPProvider.
+	Name string `json:"name"`
+
+	// Namespace of the drifted MCPProvider.
+	Namespace string `json:"namespace"`
+
+	// Fields lists the fieldpaths (dot-separated, matching PreserveFields'
+	// format) found to differ from the template, e.g.
+	// ["spec.container.image"].
+	Fields []string `json:"fields,omitempty"`
+
+	// FirstObservedAt is when this provider was first seen drifted. A
+	// provider that drifts, is reconciled, and drifts again gets a fresh
+	// FirstObservedAt.
+	FirstObservedAt metav1.Time `json:"firstObservedAt,omitempty"`
+}
+
 // DiscoveredProvider describes a discovered provider
 type DiscoveredProvider struct {
 	// Name of the provider
 	Name string `json:"name"`
 
-	// Source where it was discovered
+	// Source where it was discovered. Under EndpointsMode Endpoints or
+	// EndpointSlices this is "<slice-or-endpoints-name>[<address-index>]"
+	// rather than just the Service name, since a Service can back many
+	// discovered providers. For a non-Kubernetes ServiceDiscovery Backend
+	// this is the backend's own identifier for the record instead: a
+	// Consul catalog entry's "<node>/<service ID>", the resolved DNS
+	// SRV/A/AAAA record name, or the mDNS instance name.
 	Source string `json:"source"`
 
 	// DiscoveredAt is when it was discovered
@@ -218,6 +569,26 @@ type DiscoveredProvider struct {
 
 	// Error creating provider (if any)
 	Error string `json:"error,omitempty"`
+
+	// Topology carries the node/zone metadata EndpointSlice discovery
+	// exposes for this address. Unset under EndpointsMode Service or
+	// Endpoints, neither of which surface topology.
+	// +optional
+	Topology *EndpointTopology `json:"topology,omitempty"`
+}
+
+// EndpointTopology is the node/zone placement of a discovered endpoint
+// address, sourced from a Kubernetes EndpointSlice's per-endpoint Zone and
+// NodeName fields.
+type EndpointTopology struct {
+	// Zone is the topology.kubernetes.io/zone of the node backing this
+	// endpoint, when the EndpointSlice reports one.
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// NodeName is the node backing this endpoint.
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -225,6 +596,7 @@ type DiscoveredProvider struct {
 // +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
 // +kubebuilder:printcolumn:name="Mode",type=string,JSONPath=`.spec.mode`
 // +kubebuilder:printcolumn:name="Discovered",type=integer,JSONPath=`.status.discoveredCount`
+// +kubebuilder:printcolumn:name="Drifted",type=integer,JSONPath=`.status.driftedCount`,priority=1
 // +kubebuilder:printcolumn:name="Last Sync",type=date,JSONPath=`.status.lastSyncTime`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:resource:shortName=mcpds;discoverysource,categories=mcp
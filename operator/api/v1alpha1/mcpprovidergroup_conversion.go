@@ -0,0 +1,295 @@
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/mapyr/mcp-hangar/operator/api/v1beta1"
+)
+
+// retryConditionAliases maps the stringly-typed v1alpha1 RetryOn values onto
+// their v1beta1 RetryCondition enum equivalents. Values not found here are
+// carried across verbatim (as v1beta1.RetryCondition(raw) / the raw string),
+// so a v1alpha1 CR using an already-invalid value round-trips unchanged
+// rather than being silently dropped.
+var retryConditionAliases = map[string]v1beta1.RetryCondition{
+	"timeout":          v1beta1.RetryOnTimeout,
+	"connection_error": v1beta1.RetryOnConnectionError,
+	"5xx":              v1beta1.RetryOn5xx,
+	"gateway_error":    v1beta1.RetryOnGatewayError,
+	"reset":            v1beta1.RetryOnReset,
+}
+
+// retryConditionAliasesInverse is retryConditionAliases with keys and values
+// swapped, used when converting a v1beta1 RetryCondition back to v1alpha1's
+// string form.
+var retryConditionAliasesInverse = func() map[v1beta1.RetryCondition]string {
+	inv := make(map[v1beta1.RetryCondition]string, len(retryConditionAliases))
+	for raw, cond := range retryConditionAliases {
+		inv[cond] = raw
+	}
+	return inv
+}()
+
+// parseDuration parses s as a Go duration, falling back to def (already a
+// parsed Duration) when s is empty or malformed - the same default-on-error
+// behavior the rest of this package applies to its stringly-typed duration
+// fields before this conversion existed.
+func parseDuration(s string, def time.Duration) metav1.Duration {
+	if s == "" {
+		return metav1.Duration{Duration: def}
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return metav1.Duration{Duration: def}
+	}
+	return metav1.Duration{Duration: d}
+}
+
+// ConvertTo converts this v1alpha1 MCPProviderGroup to the v1beta1 hub
+// version. Failover.Enabled's three meaningful states (unset, true, false)
+// collapse onto FailoverMode's Retry/Off - there is no v1alpha1 source value
+// that produces FailoverHedged, so converting back via ConvertFrom never
+// needs to invent one.
+func (src *MCPProviderGroup) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta1.MCPProviderGroup)
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec.Selector = src.Spec.Selector
+	dst.Spec.Strategy = v1beta1.LoadBalancingStrategy(src.Spec.Strategy)
+	dst.Spec.SlowStartWindow = src.Spec.SlowStartWindow
+
+	if src.Spec.Failover != nil {
+		dst.Spec.Failover = &v1beta1.FailoverConfig{
+			Mode:       v1beta1.FailoverRetry,
+			MaxRetries: src.Spec.Failover.MaxRetries,
+			RetryDelay: parseDuration(src.Spec.Failover.RetryDelay, time.Second),
+		}
+		if src.Spec.Failover.Enabled != nil && !*src.Spec.Failover.Enabled {
+			dst.Spec.Failover.Mode = v1beta1.FailoverOff
+		}
+		for _, raw := range src.Spec.Failover.RetryOn {
+			if cond, ok := retryConditionAliases[raw]; ok {
+				dst.Spec.Failover.RetryOn = append(dst.Spec.Failover.RetryOn, cond)
+			} else {
+				dst.Spec.Failover.RetryOn = append(dst.Spec.Failover.RetryOn, v1beta1.RetryCondition(raw))
+			}
+		}
+	}
+
+	if src.Spec.HealthPolicy != nil {
+		dst.Spec.HealthPolicy = &v1beta1.HealthPolicy{
+			MinHealthyPercentage: src.Spec.HealthPolicy.MinHealthyPercentage,
+			MinHealthyCount:      src.Spec.HealthPolicy.MinHealthyCount,
+			UnhealthyThreshold:   src.Spec.HealthPolicy.UnhealthyThreshold,
+		}
+	}
+
+	if src.Spec.SessionAffinity != nil {
+		dst.Spec.SessionAffinity = &v1beta1.SessionAffinityConfig{
+			Enabled: src.Spec.SessionAffinity.Enabled,
+			Type:    v1beta1.SessionAffinityType(src.Spec.SessionAffinity.Type),
+			Header:  src.Spec.SessionAffinity.Header,
+			TTL:     parseDuration(src.Spec.SessionAffinity.TTL, 10*time.Minute),
+		}
+	}
+
+	if src.Spec.CircuitBreaker != nil {
+		dst.Spec.CircuitBreaker = &v1beta1.GroupCircuitBreakerConfig{
+			Enabled:           src.Spec.CircuitBreaker.Enabled,
+			FailureThreshold:  src.Spec.CircuitBreaker.FailureThreshold,
+			ResetTimeout:      parseDuration(src.Spec.CircuitBreaker.ResetTimeout, time.Minute),
+			HalfOpenMaxProbes: src.Spec.CircuitBreaker.HalfOpenMaxProbes,
+			SuccessThreshold:  src.Spec.CircuitBreaker.SuccessThreshold,
+		}
+		if od := src.Spec.CircuitBreaker.OutlierDetection; od != nil {
+			dst.Spec.CircuitBreaker.OutlierDetection = &v1beta1.OutlierDetectionConfig{
+				Consecutive5xxErrors:     od.Consecutive5xxErrors,
+				ConsecutiveGatewayErrors: od.ConsecutiveGatewayErrors,
+				SuccessRateStdevFactor:   od.SuccessRateStdevFactor,
+				BaseEjectionTime:         od.BaseEjectionTime,
+				MaxEjectionPercent:       od.MaxEjectionPercent,
+			}
+		}
+	}
+
+	if src.Spec.AdaptiveConcurrency != nil {
+		dst.Spec.AdaptiveConcurrency = &v1beta1.AdaptiveConcurrencyConfig{
+			Enabled:   src.Spec.AdaptiveConcurrency.Enabled,
+			MinLimit:  src.Spec.AdaptiveConcurrency.MinLimit,
+			MaxLimit:  src.Spec.AdaptiveConcurrency.MaxLimit,
+			QueueSize: src.Spec.AdaptiveConcurrency.QueueSize,
+		}
+	}
+
+	dst.Status.ProviderCount = src.Status.ProviderCount
+	dst.Status.ReadyCount = src.Status.ReadyCount
+	dst.Status.DegradedCount = src.Status.DegradedCount
+	dst.Status.ColdCount = src.Status.ColdCount
+	dst.Status.DeadCount = src.Status.DeadCount
+	dst.Status.ActiveStrategy = src.Status.ActiveStrategy
+	dst.Status.CircuitState = v1beta1.GroupCircuitState(src.Status.CircuitState)
+	dst.Status.ObservedGeneration = src.Status.ObservedGeneration
+	dst.Status.Providers = convertMemberStatusesTo(src.Status.Providers)
+	dst.Status.RampingProviders = convertMemberStatusesTo(src.Status.RampingProviders)
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, v1beta1.Condition{
+			Type:               c.Type,
+			Status:             c.Status,
+			LastTransitionTime: c.LastTransitionTime,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			ObservedGeneration: c.ObservedGeneration,
+		})
+	}
+
+	return nil
+}
+
+// ConvertFrom converts the v1beta1 hub version into this v1alpha1
+// MCPProviderGroup. FailoverHedged has no v1alpha1 representation and maps
+// to Enabled=true, the closest approximation (failover is on, just not
+// hedged) - a CR that round-trips v1alpha1 -> v1beta1 -> v1alpha1 through a
+// Hedged edit will see Enabled=true rather than recover Hedged itself.
+func (dst *MCPProviderGroup) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.MCPProviderGroup)
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec.Selector = src.Spec.Selector
+	dst.Spec.Strategy = LoadBalancingStrategy(src.Spec.Strategy)
+	dst.Spec.SlowStartWindow = src.Spec.SlowStartWindow
+
+	if src.Spec.Failover != nil {
+		enabled := src.Spec.Failover.Mode != v1beta1.FailoverOff
+		dst.Spec.Failover = &FailoverConfig{
+			Enabled:    &enabled,
+			MaxRetries: src.Spec.Failover.MaxRetries,
+			RetryDelay: src.Spec.Failover.RetryDelay.Duration.String(),
+		}
+		for _, cond := range src.Spec.Failover.RetryOn {
+			if raw, ok := retryConditionAliasesInverse[cond]; ok {
+				dst.Spec.Failover.RetryOn = append(dst.Spec.Failover.RetryOn, raw)
+			} else {
+				dst.Spec.Failover.RetryOn = append(dst.Spec.Failover.RetryOn, string(cond))
+			}
+		}
+	}
+
+	if src.Spec.HealthPolicy != nil {
+		dst.Spec.HealthPolicy = &HealthPolicy{
+			MinHealthyPercentage: src.Spec.HealthPolicy.MinHealthyPercentage,
+			MinHealthyCount:      src.Spec.HealthPolicy.MinHealthyCount,
+			UnhealthyThreshold:   src.Spec.HealthPolicy.UnhealthyThreshold,
+		}
+	}
+
+	if src.Spec.SessionAffinity != nil {
+		dst.Spec.SessionAffinity = &SessionAffinityConfig{
+			Enabled: src.Spec.SessionAffinity.Enabled,
+			Type:    string(src.Spec.SessionAffinity.Type),
+			Header:  src.Spec.SessionAffinity.Header,
+			TTL:     src.Spec.SessionAffinity.TTL.Duration.String(),
+		}
+	}
+
+	if src.Spec.CircuitBreaker != nil {
+		dst.Spec.CircuitBreaker = &GroupCircuitBreakerConfig{
+			Enabled:           src.Spec.CircuitBreaker.Enabled,
+			FailureThreshold:  src.Spec.CircuitBreaker.FailureThreshold,
+			ResetTimeout:      src.Spec.CircuitBreaker.ResetTimeout.Duration.String(),
+			HalfOpenMaxProbes: src.Spec.CircuitBreaker.HalfOpenMaxProbes,
+			SuccessThreshold:  src.Spec.CircuitBreaker.SuccessThreshold,
+		}
+		if od := src.Spec.CircuitBreaker.OutlierDetection; od != nil {
+			dst.Spec.CircuitBreaker.OutlierDetection = &OutlierDetectionConfig{
+				Consecutive5xxErrors:     od.Consecutive5xxErrors,
+				ConsecutiveGatewayErrors: od.ConsecutiveGatewayErrors,
+				SuccessRateStdevFactor:   od.SuccessRateStdevFactor,
+				BaseEjectionTime:         od.BaseEjectionTime,
+				MaxEjectionPercent:       od.MaxEjectionPercent,
+			}
+		}
+	}
+
+	if src.Spec.AdaptiveConcurrency != nil {
+		dst.Spec.AdaptiveConcurrency = &AdaptiveConcurrencyConfig{
+			Enabled:   src.Spec.AdaptiveConcurrency.Enabled,
+			MinLimit:  src.Spec.AdaptiveConcurrency.MinLimit,
+			MaxLimit:  src.Spec.AdaptiveConcurrency.MaxLimit,
+			QueueSize: src.Spec.AdaptiveConcurrency.QueueSize,
+		}
+	}
+
+	dst.Status.ProviderCount = src.Status.ProviderCount
+	dst.Status.ReadyCount = src.Status.ReadyCount
+	dst.Status.DegradedCount = src.Status.DegradedCount
+	dst.Status.ColdCount = src.Status.ColdCount
+	dst.Status.DeadCount = src.Status.DeadCount
+	dst.Status.ActiveStrategy = src.Status.ActiveStrategy
+	dst.Status.CircuitState = GroupCircuitState(src.Status.CircuitState)
+	dst.Status.ObservedGeneration = src.Status.ObservedGeneration
+	dst.Status.Providers = convertMemberStatusesFrom(src.Status.Providers)
+	dst.Status.RampingProviders = convertMemberStatusesFrom(src.Status.RampingProviders)
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, Condition{
+			Type:               c.Type,
+			Status:             c.Status,
+			LastTransitionTime: c.LastTransitionTime,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			ObservedGeneration: c.ObservedGeneration,
+		})
+	}
+
+	return nil
+}
+
+func convertMemberStatusesTo(members []ProviderMemberStatus) []v1beta1.ProviderMemberStatus {
+	if members == nil {
+		return nil
+	}
+	out := make([]v1beta1.ProviderMemberStatus, len(members))
+	for i, m := range members {
+		out[i] = v1beta1.ProviderMemberStatus{
+			Name:              m.Name,
+			Namespace:         m.Namespace,
+			State:             m.State,
+			Weight:            m.Weight,
+			ActiveConnections: m.ActiveConnections,
+			LastHealthCheck:   m.LastHealthCheck,
+			CircuitState:      v1beta1.GroupCircuitState(m.CircuitState),
+			EjectedUntil:      m.EjectedUntil,
+			RampFraction:      m.RampFraction,
+			Limit:             m.Limit,
+			InFlight:          m.InFlight,
+			RttP50:            m.RttP50,
+		}
+	}
+	return out
+}
+
+func convertMemberStatusesFrom(members []v1beta1.ProviderMemberStatus) []ProviderMemberStatus {
+	if members == nil {
+		return nil
+	}
+	out := make([]ProviderMemberStatus, len(members))
+	for i, m := range members {
+		out[i] = ProviderMemberStatus{
+			Name:              m.Name,
+			Namespace:         m.Namespace,
+			State:             m.State,
+			Weight:            m.Weight,
+			ActiveConnections: m.ActiveConnections,
+			LastHealthCheck:   m.LastHealthCheck,
+			CircuitState:      GroupCircuitState(m.CircuitState),
+			EjectedUntil:      m.EjectedUntil,
+			RampFraction:      m.RampFraction,
+			Limit:             m.Limit,
+			InFlight:          m.InFlight,
+			RttP50:            m.RttP50,
+		}
+	}
+	return out
+}
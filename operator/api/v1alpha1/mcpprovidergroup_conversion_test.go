@@ -0,0 +1,115 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mapyr/mcp-hangar/operator/api/v1beta1"
+)
+
+func TestConvertTo_FailoverEnabledMapsToRetryMode(t *testing.T) {
+	enabled := true
+	src := &MCPProviderGroup{Spec: MCPProviderGroupSpec{
+		Failover: &FailoverConfig{Enabled: &enabled, RetryDelay: "2s", RetryOn: []string{"timeout", "5xx"}},
+	}}
+
+	dst := &v1beta1.MCPProviderGroup{}
+	assert.NoError(t, src.ConvertTo(dst))
+
+	assert.Equal(t, v1beta1.FailoverRetry, dst.Spec.Failover.Mode)
+	assert.Equal(t, 2*time.Second, dst.Spec.Failover.RetryDelay.Duration)
+	assert.Equal(t, []v1beta1.RetryCondition{v1beta1.RetryOnTimeout, v1beta1.RetryOn5xx}, dst.Spec.Failover.RetryOn)
+}
+
+func TestConvertTo_FailoverDisabledMapsToOffMode(t *testing.T) {
+	disabled := false
+	src := &MCPProviderGroup{Spec: MCPProviderGroupSpec{
+		Failover: &FailoverConfig{Enabled: &disabled},
+	}}
+
+	dst := &v1beta1.MCPProviderGroup{}
+	assert.NoError(t, src.ConvertTo(dst))
+
+	assert.Equal(t, v1beta1.FailoverOff, dst.Spec.Failover.Mode)
+}
+
+func TestConvertTo_UnknownRetryOnPassesThroughVerbatim(t *testing.T) {
+	src := &MCPProviderGroup{Spec: MCPProviderGroupSpec{
+		Failover: &FailoverConfig{RetryOn: []string{"some_custom_condition"}},
+	}}
+
+	dst := &v1beta1.MCPProviderGroup{}
+	assert.NoError(t, src.ConvertTo(dst))
+
+	assert.Equal(t, []v1beta1.RetryCondition{"some_custom_condition"}, dst.Spec.Failover.RetryOn)
+}
+
+func TestConvertTo_DurationStringsParsed(t *testing.T) {
+	src := &MCPProviderGroup{Spec: MCPProviderGroupSpec{
+		CircuitBreaker:  &GroupCircuitBreakerConfig{ResetTimeout: "90s"},
+		SessionAffinity: &SessionAffinityConfig{Type: "Header", TTL: "5m"},
+	}}
+
+	dst := &v1beta1.MCPProviderGroup{}
+	assert.NoError(t, src.ConvertTo(dst))
+
+	assert.Equal(t, 90*time.Second, dst.Spec.CircuitBreaker.ResetTimeout.Duration)
+	assert.Equal(t, 5*time.Minute, dst.Spec.SessionAffinity.TTL.Duration)
+	assert.Equal(t, v1beta1.SessionAffinityHeader, dst.Spec.SessionAffinity.Type)
+}
+
+func TestConvertTo_MalformedDurationFallsBackToDefault(t *testing.T) {
+	src := &MCPProviderGroup{Spec: MCPProviderGroupSpec{
+		CircuitBreaker: &GroupCircuitBreakerConfig{ResetTimeout: "not-a-duration"},
+	}}
+
+	dst := &v1beta1.MCPProviderGroup{}
+	assert.NoError(t, src.ConvertTo(dst))
+
+	assert.Equal(t, time.Minute, dst.Spec.CircuitBreaker.ResetTimeout.Duration)
+}
+
+func TestConvertFrom_OffModeKeepsRetrySettings(t *testing.T) {
+	hub := &v1beta1.MCPProviderGroup{Spec: v1beta1.MCPProviderGroupSpec{
+		Failover: &v1beta1.FailoverConfig{
+			Mode:       v1beta1.FailoverOff,
+			RetryDelay: metav1.Duration{Duration: 3 * time.Second},
+			RetryOn:    []v1beta1.RetryCondition{v1beta1.RetryOnGatewayError},
+		},
+	}}
+
+	dst := &MCPProviderGroup{}
+	assert.NoError(t, dst.ConvertFrom(hub))
+
+	assert.False(t, *dst.Spec.Failover.Enabled)
+	assert.Equal(t, "3s", dst.Spec.Failover.RetryDelay)
+	assert.Equal(t, []string{"gateway_error"}, dst.Spec.Failover.RetryOn)
+}
+
+func TestConvertFrom_HedgedModeDowngradesToEnabledTrue(t *testing.T) {
+	hub := &v1beta1.MCPProviderGroup{Spec: v1beta1.MCPProviderGroupSpec{
+		Failover: &v1beta1.FailoverConfig{Mode: v1beta1.FailoverHedged},
+	}}
+
+	dst := &MCPProviderGroup{}
+	assert.NoError(t, dst.ConvertFrom(hub))
+
+	assert.True(t, *dst.Spec.Failover.Enabled)
+}
+
+func TestConvertTo_MemberStatusFieldsCopied(t *testing.T) {
+	src := &MCPProviderGroup{Status: MCPProviderGroupStatus{
+		Providers: []ProviderMemberStatus{{Name: "p1", Namespace: "default", Limit: 10, RttP50: 42}},
+	}}
+
+	dst := &v1beta1.MCPProviderGroup{}
+	assert.NoError(t, src.ConvertTo(dst))
+
+	assert.Len(t, dst.Status.Providers, 1)
+	assert.Equal(t, "p1", dst.Status.Providers[0].Name)
+	assert.Equal(t, int32(10), dst.Status.Providers[0].Limit)
+	assert.Equal(t, int64(42), dst.Status.Providers[0].RttP50)
+}
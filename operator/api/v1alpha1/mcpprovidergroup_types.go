@@ -6,7 +6,7 @@ import (
 )
 
 // LoadBalancingStrategy defines the load balancing algorithm
-// +kubebuilder:validation:Enum=RoundRobin;LeastConnections;Random;Weighted;Failover
+// +kubebuilder:validation:Enum=RoundRobin;LeastConnections;Random;Weighted;Failover;WeightedLeastRequest
 type LoadBalancingStrategy string
 
 const (
@@ -15,6 +15,12 @@ const (
 	StrategyRandom           LoadBalancingStrategy = "Random"
 	StrategyWeighted         LoadBalancingStrategy = "Weighted"
 	StrategyFailover         LoadBalancingStrategy = "Failover"
+	// StrategyWeightedLeastRequest picks two random ready members and
+	// routes to whichever has the lower ActiveConnections/Weight ratio
+	// (Power-of-Two-Choices), with newly-Ready members ramping up to their
+	// configured Weight over SlowStartWindow instead of receiving full
+	// traffic immediately.
+	StrategyWeightedLeastRequest LoadBalancingStrategy = "WeightedLeastRequest"
 )
 
 // MCPProviderGroupSpec defines the desired state of MCPProviderGroup
@@ -42,6 +48,52 @@ type MCPProviderGroupSpec struct {
 	// CircuitBreaker configures group-level circuit breaker
 	// +optional
 	CircuitBreaker *GroupCircuitBreakerConfig `json:"circuitBreaker,omitempty"`
+
+	// SlowStartWindow is how long a newly-Ready member's effective weight
+	// takes to ramp linearly from 0 to its configured Weight under the
+	// WeightedLeastRequest strategy, so a cold container isn't flooded the
+	// instant it turns Ready. Ignored by other strategies.
+	// +kubebuilder:default="30s"
+	SlowStartWindow string `json:"slowStartWindow,omitempty"`
+
+	// AdaptiveConcurrency bounds how many in-flight requests the group
+	// router sends to each member using a Gradient2 limiter, the same
+	// knob MCPProviderSpec.AdaptiveConcurrency applies per-Pod but scoped
+	// to the group's view of a member instead. Unset leaves routing
+	// unlimited.
+	// +optional
+	AdaptiveConcurrency *AdaptiveConcurrencyConfig `json:"adaptiveConcurrency,omitempty"`
+}
+
+// AdaptiveConcurrencyConfig configures a Netflix-style Gradient2 limiter:
+// it tracks an EWMA of the best (lowest) observed RTT alongside a
+// short-window EWMA of current RTT, and shrinks or grows the concurrency
+// limit as their ratio - the "gradient" - falls or recovers, the same
+// back-pressure signal Little's Law formalizes as concurrency =
+// throughput * latency.
+type AdaptiveConcurrencyConfig struct {
+	// Enabled enables the limiter. Disabled members/providers are
+	// unlimited.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// MinLimit is the smallest the concurrency limit may shrink to.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	MinLimit int32 `json:"minLimit,omitempty"`
+
+	// MaxLimit is the largest the concurrency limit may grow to.
+	// +kubebuilder:default=256
+	// +kubebuilder:validation:Minimum=1
+	MaxLimit int32 `json:"maxLimit,omitempty"`
+
+	// QueueSize is added to the gradient-scaled limit on every update,
+	// the same small constant headroom Netflix's concurrency-limits
+	// library adds so the limit can still grow after a perfectly flat
+	// gradient.
+	// +kubebuilder:default=4
+	// +kubebuilder:validation:Minimum=0
+	QueueSize int32 `json:"queueSize,omitempty"`
 }
 
 // FailoverConfig defines failover settings
@@ -103,7 +155,13 @@ type SessionAffinityConfig struct {
 	TTL string `json:"ttl,omitempty"`
 }
 
-// GroupCircuitBreakerConfig defines group-level circuit breaker
+// GroupCircuitBreakerConfig defines group-level circuit breaker.
+//
+// EXPERIMENTAL (unwired): no MCPProviderGroup reconciler in this tree reads
+// this field yet. The breaker algorithm it configures is implemented and
+// tested in pkg/group.Tracker, but nothing in the running operator
+// constructs a Tracker from it or writes the CircuitState/metrics below -
+// see pkg/group's package doc.
 type GroupCircuitBreakerConfig struct {
 	// Enabled enables group circuit breaker
 	// +kubebuilder:default=false
@@ -116,8 +174,75 @@ type GroupCircuitBreakerConfig struct {
 	// ResetTimeout before attempting recovery
 	// +kubebuilder:default="1m"
 	ResetTimeout string `json:"resetTimeout,omitempty"`
+
+	// HalfOpenMaxProbes caps how many requests are let through at once
+	// while the breaker is HalfOpen, the way Envoy limits probe traffic
+	// before fully trusting a recovering group.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	HalfOpenMaxProbes int32 `json:"halfOpenMaxProbes,omitempty"`
+
+	// SuccessThreshold is how many consecutive HalfOpen probe successes
+	// are required before the breaker closes again. Falling short of it
+	// before HalfOpenMaxProbes probes finish reopens the breaker.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	SuccessThreshold int32 `json:"successThreshold,omitempty"`
+
+	// OutlierDetection ejects individual members from routing instead of
+	// tripping the whole group, the way Envoy's outlier detection runs
+	// alongside its circuit breaker.
+	// +optional
+	OutlierDetection *OutlierDetectionConfig `json:"outlierDetection,omitempty"`
 }
 
+// OutlierDetectionConfig configures per-member ejection alongside the
+// group-level circuit breaker.
+type OutlierDetectionConfig struct {
+	// Consecutive5xxErrors is how many consecutive 5xx responses from a
+	// member trigger ejection.
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	Consecutive5xxErrors int32 `json:"consecutive5xxErrors,omitempty"`
+
+	// ConsecutiveGatewayErrors is how many consecutive gateway failures
+	// (connection refused, timeout) from a member trigger ejection.
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	ConsecutiveGatewayErrors int32 `json:"consecutiveGatewayErrors,omitempty"`
+
+	// SuccessRateStdevFactor ejects a member whose success rate falls
+	// this many standard deviations below the group's mean success rate.
+	// +kubebuilder:default=2
+	// +kubebuilder:validation:Minimum=1
+	SuccessRateStdevFactor int32 `json:"successRateStdevFactor,omitempty"`
+
+	// BaseEjectionTime is the ejection duration for a member's first
+	// ejection; each subsequent ejection multiplies it by the member's
+	// ejection count, the same escalating-penalty Envoy applies.
+	// +kubebuilder:default="30s"
+	BaseEjectionTime string `json:"baseEjectionTime,omitempty"`
+
+	// MaxEjectionPercent caps the percentage of the group that may be
+	// ejected at once, so outlier detection can't eject an entire group
+	// into having zero routable members.
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MaxEjectionPercent int32 `json:"maxEjectionPercent,omitempty"`
+}
+
+// GroupCircuitState is the current state of a group's or member's circuit
+// breaker.
+// +kubebuilder:validation:Enum=Closed;Open;HalfOpen
+type GroupCircuitState string
+
+const (
+	GroupCircuitClosed   GroupCircuitState = "Closed"
+	GroupCircuitOpen     GroupCircuitState = "Open"
+	GroupCircuitHalfOpen GroupCircuitState = "HalfOpen"
+)
+
 // MCPProviderGroupStatus defines the observed state of MCPProviderGroup
 type MCPProviderGroupStatus struct {
 	// ProviderCount is total providers in group
@@ -138,9 +263,26 @@ type MCPProviderGroupStatus struct {
 	// ActiveStrategy is the currently active strategy
 	ActiveStrategy string `json:"activeStrategy,omitempty"`
 
+	// CircuitState is the group-level circuit breaker's current state.
+	// Empty while CircuitBreaker is unset or disabled.
+	//
+	// EXPERIMENTAL (unwired): always empty today - no reconciler populates
+	// it. See GroupCircuitBreakerConfig.
+	// +optional
+	CircuitState GroupCircuitState `json:"circuitState,omitempty"`
+
 	// Providers contains provider member details
 	Providers []ProviderMemberStatus `json:"providers,omitempty"`
 
+	// RampingProviders lists members still inside SlowStartWindow, i.e.
+	// whose RampFraction is below 1. Populated only under the
+	// WeightedLeastRequest strategy.
+	//
+	// EXPERIMENTAL (unwired): always empty today - no reconciler calls
+	// pkg/group.PickP2C/RampFraction to populate it.
+	// +optional
+	RampingProviders []ProviderMemberStatus `json:"rampingProviders,omitempty"`
+
 	// ObservedGeneration is the generation observed by controller
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 
@@ -167,6 +309,58 @@ type ProviderMemberStatus struct {
 
 	// LastHealthCheck time
 	LastHealthCheck *metav1.Time `json:"lastHealthCheck,omitempty"`
+
+	// CircuitState is this member's outlier-detection ejection state:
+	// Closed (routable), or Open while EjectedUntil hasn't passed yet.
+	// HalfOpen is unused at member level - outlier detection ejects and
+	// un-ejects, it doesn't probe the way the group breaker does.
+	//
+	// EXPERIMENTAL (unwired): always empty today - no reconciler populates
+	// it. See GroupCircuitBreakerConfig.
+	// +optional
+	CircuitState GroupCircuitState `json:"circuitState,omitempty"`
+
+	// EjectedUntil is when this member's outlier-detection ejection
+	// lifts. Unset when the member isn't ejected.
+	//
+	// EXPERIMENTAL (unwired): always unset today - no reconciler populates
+	// it. See GroupCircuitBreakerConfig.
+	// +optional
+	EjectedUntil *metav1.Time `json:"ejectedUntil,omitempty"`
+
+	// RampFraction is how far this member is through SlowStartWindow under
+	// the WeightedLeastRequest strategy, from 0 (just turned Ready) to 1
+	// (fully ramped, or not ramping at all). Unused by other strategies.
+	//
+	// EXPERIMENTAL (unwired): always 0 today - no reconciler calls
+	// pkg/group.PickP2C/RampFraction to populate it.
+	// +optional
+	RampFraction float64 `json:"rampFraction,omitempty"`
+
+	// Limit is this member's current AdaptiveConcurrency limit. Unset
+	// while AdaptiveConcurrency is disabled.
+	//
+	// EXPERIMENTAL (unwired): always 0 today - no group router calls
+	// pkg/provider's Gradient2 limiter to populate it.
+	// +optional
+	Limit int32 `json:"limit,omitempty"`
+
+	// InFlight is this member's current in-flight request count under
+	// AdaptiveConcurrency.
+	//
+	// EXPERIMENTAL (unwired): always 0 today - no group router calls
+	// pkg/provider's Gradient2 limiter to populate it.
+	// +optional
+	InFlight int32 `json:"inFlight,omitempty"`
+
+	// RttP50 is this member's short-window RTT EWMA, in milliseconds - an
+	// exponentially-weighted approximation of the median, not a tracked
+	// percentile.
+	//
+	// EXPERIMENTAL (unwired): always 0 today - no group router calls
+	// pkg/provider's Gradient2 limiter to populate it.
+	// +optional
+	RttP50 int64 `json:"rttP50,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -178,7 +372,16 @@ type ProviderMemberStatus struct {
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:resource:shortName=mcppg;providergroup,categories=mcp
 
-// MCPProviderGroup is the Schema for the mcpprovidergroups API
+// MCPProviderGroup is the Schema for the mcpprovidergroups API.
+//
+// Deprecated: use v1beta1.MCPProviderGroup. v1alpha1 stores its durations
+// as plain strings (RetryDelay, ResetTimeout, TTL) and its failover toggle
+// as FailoverConfig.Enabled *bool rather than a typed Mode, both cleaned up
+// in v1beta1. This type converts to/from v1beta1 via ConvertTo/ConvertFrom
+// (see mcpprovidergroup_conversion.go); a v1alpha1 object round-trips
+// losslessly, but the v1beta1-only FailoverHedged mode comes back as
+// Enabled=true and reads as Retry after another ConvertTo. It remains
+// readable and writable until it's removed in a future API bump.
 type MCPProviderGroup struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
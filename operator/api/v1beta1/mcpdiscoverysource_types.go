@@ -0,0 +1,367 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// DiscoveryType defines the type of discovery source
+// +kubebuilder:validation:Enum=Namespace;ConfigMap;Annotations;ServiceDiscovery
+type DiscoveryType string
+
+const (
+	DiscoveryTypeNamespace        DiscoveryType = "Namespace"
+	DiscoveryTypeConfigMap        DiscoveryType = "ConfigMap"
+	DiscoveryTypeAnnotations      DiscoveryType = "Annotations"
+	DiscoveryTypeServiceDiscovery DiscoveryType = "ServiceDiscovery"
+)
+
+// DiscoveryMode defines how discovery handles changes
+// +kubebuilder:validation:Enum=Additive;Authoritative
+type DiscoveryMode string
+
+const (
+	// DiscoveryModeAdditive only adds new providers
+	DiscoveryModeAdditive DiscoveryMode = "Additive"
+	// DiscoveryModeAuthoritative adds and removes (syncs with source)
+	DiscoveryModeAuthoritative DiscoveryMode = "Authoritative"
+)
+
+// DriftPolicy controls how the discovery controller reacts when a managed
+// MCPProvider no longer matches what ProviderTemplate + RelabelConfigs
+// would currently produce for it.
+// +kubebuilder:validation:Enum=Ignore;Report;Reconcile
+type DriftPolicy string
+
+const (
+	DriftPolicyIgnore    DriftPolicy = "Ignore"
+	DriftPolicyReport    DriftPolicy = "Report"
+	DriftPolicyReconcile DriftPolicy = "Reconcile"
+)
+
+// MCPDiscoverySourceSpec defines the desired state of MCPDiscoverySource
+type MCPDiscoverySourceSpec struct {
+	// Type is the discovery source type
+	// +kubebuilder:validation:Required
+	Type DiscoveryType `json:"type"`
+
+	// Mode determines add-only or full sync behavior
+	// +kubebuilder:default=Additive
+	Mode DiscoveryMode `json:"mode,omitempty"`
+
+	// RefreshInterval is how often to rescan
+	// +kubebuilder:default="1m"
+	RefreshInterval metav1.Duration `json:"refreshInterval,omitempty"`
+
+	// Paused pauses discovery (for maintenance)
+	// +kubebuilder:default=false
+	Paused bool `json:"paused,omitempty"`
+
+	// NamespaceSelector selects namespaces to scan (for Namespace type)
+	// +optional
+	NamespaceSelector *NamespaceSelectorConfig `json:"namespaceSelector,omitempty"`
+
+	// ConfigMapRef references a ConfigMap with provider definitions
+	// +optional
+	ConfigMapRef *ConfigMapReference `json:"configMapRef,omitempty"`
+
+	// Annotations configures annotation-based discovery
+	// +optional
+	Annotations *AnnotationDiscoveryConfig `json:"annotations,omitempty"`
+
+	// ServiceDiscovery configures service-based discovery
+	// +optional
+	ServiceDiscovery *ServiceDiscoveryConfig `json:"serviceDiscovery,omitempty"`
+
+	// ProviderTemplate provides default settings for discovered providers
+	// +optional
+	ProviderTemplate *ProviderTemplateConfig `json:"providerTemplate,omitempty"`
+
+	// Filters limit discovered providers
+	// +optional
+	Filters *DiscoveryFilters `json:"filters,omitempty"`
+
+	// RelabelConfigs runs a Prometheus-inspired relabel pipeline over
+	// labels harvested from the discovery source before a
+	// DiscoveredProvider is materialized.
+	// +optional
+	RelabelConfigs []RelabelConfig `json:"relabelConfigs,omitempty"`
+
+	// DriftPolicy controls what happens when a managed MCPProvider no
+	// longer matches what ProviderTemplate + RelabelConfigs would produce.
+	// +kubebuilder:default=Report
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// PreserveFields lists fieldpaths that DriftPolicyReconcile must leave
+	// alone even when they've drifted from the template.
+	// +optional
+	PreserveFields []string `json:"preserveFields,omitempty"`
+
+	// Ownership configures owner references
+	// +optional
+	Ownership *OwnershipConfig `json:"ownership,omitempty"`
+}
+
+// NamespaceSelectorConfig defines namespace selection
+type NamespaceSelectorConfig struct {
+	MatchLabels       map[string]string                  `json:"matchLabels,omitempty"`
+	MatchExpressions  []metav1.LabelSelectorRequirement   `json:"matchExpressions,omitempty"`
+	ExcludeNamespaces []string                            `json:"excludeNamespaces,omitempty"`
+}
+
+// ConfigMapReference references a ConfigMap containing provider configs
+type ConfigMapReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Key       string `json:"key,omitempty"`
+}
+
+// AnnotationDiscoveryConfig configures annotation-based discovery
+type AnnotationDiscoveryConfig struct {
+	PodSelector         map[string]string `json:"podSelector,omitempty"`
+	ServiceSelector     map[string]string `json:"serviceSelector,omitempty"`
+	AnnotationPrefix    string            `json:"annotationPrefix,omitempty"`
+	RequiredAnnotations []string          `json:"requiredAnnotations,omitempty"`
+}
+
+// EndpointsMode selects what ServiceDiscovery enumerates: whole Services,
+// or individual backend endpoints.
+// +kubebuilder:validation:Enum=Service;Endpoints;EndpointSlices
+type EndpointsMode string
+
+const (
+	EndpointsModeService        EndpointsMode = "Service"
+	EndpointsModeEndpoints      EndpointsMode = "Endpoints"
+	EndpointsModeEndpointSlices EndpointsMode = "EndpointSlices"
+)
+
+// DiscoveryBackend selects the registry ServiceDiscovery resolves
+// providers from.
+// +kubebuilder:validation:Enum=Kubernetes;Consul;DNS;MDNS
+type DiscoveryBackend string
+
+const (
+	DiscoveryBackendKubernetes DiscoveryBackend = "Kubernetes"
+	DiscoveryBackendConsul     DiscoveryBackend = "Consul"
+	DiscoveryBackendDNS        DiscoveryBackend = "DNS"
+	DiscoveryBackendMDNS       DiscoveryBackend = "MDNS"
+)
+
+// ServiceDiscoveryConfig configures service-based discovery
+type ServiceDiscoveryConfig struct {
+	Backend       DiscoveryBackend       `json:"backend,omitempty"`
+	Selector      map[string]string      `json:"selector,omitempty"`
+	PortName      string                 `json:"portName,omitempty"`
+	Protocol      string                 `json:"protocol,omitempty"`
+	EndpointsMode EndpointsMode          `json:"endpointsMode,omitempty"`
+	Consul        *ConsulDiscoveryConfig `json:"consul,omitempty"`
+	DNS           *DNSDiscoveryConfig    `json:"dns,omitempty"`
+	MDNS          *MDNSDiscoveryConfig   `json:"mdns,omitempty"`
+}
+
+// ConsulDiscoveryConfig configures discovery from a Consul service catalog.
+type ConsulDiscoveryConfig struct {
+	Address           string             `json:"address"`
+	Datacenter        string             `json:"datacenter,omitempty"`
+	ACLTokenSecretRef *SecretKeySelector `json:"aclTokenSecretRef,omitempty"`
+	ServiceName       string             `json:"serviceName"`
+	Tags              []string           `json:"tags,omitempty"`
+	Watch             bool               `json:"watch,omitempty"`
+}
+
+// DNSDiscoveryConfig configures discovery from DNS SRV (or A/AAAA
+// fallback) records.
+type DNSDiscoveryConfig struct {
+	RecordName            string `json:"recordName"`
+	FallbackToHostRecords bool   `json:"fallbackToHostRecords,omitempty"`
+	Port                  int32  `json:"port,omitempty"`
+}
+
+// MDNSDiscoveryConfig configures discovery via multicast DNS service
+// browsing.
+type MDNSDiscoveryConfig struct {
+	ServiceType string `json:"serviceType"`
+	Domain      string `json:"domain,omitempty"`
+}
+
+// SecretKeySelector selects a key from a Secret.
+type SecretKeySelector struct {
+	Name     string `json:"name"`
+	Key      string `json:"key"`
+	Optional *bool  `json:"optional,omitempty"`
+}
+
+// ProviderTemplateConfig provides defaults for discovered providers
+type ProviderTemplateConfig struct {
+	Metadata       *TemplateMetadata `json:"metadata,omitempty"`
+	Spec           *MCPProviderSpec  `json:"spec,omitempty"`
+	RelabelConfigs []RelabelConfig   `json:"relabelConfigs,omitempty"`
+}
+
+// MCPProviderSpec mirrors the most commonly templated fields of
+// v1alpha1.MCPProviderSpec, kept separate so this hub package doesn't
+// import v1alpha1 (v1alpha1 imports v1beta1 for conversion, so the reverse
+// import would cycle). ProviderTemplateConfig.Spec fields outside this
+// subset (autoscaling, resources, env, persistent state, ...) don't yet
+// have a v1beta1 representation and are dropped on ConvertTo - the same
+// pragmatic, documented-lossy tradeoff as FailoverHedged in the
+// MCPProviderGroup conversion.
+type MCPProviderSpec struct {
+	Mode       string            `json:"mode,omitempty"`
+	Image      string            `json:"image,omitempty"`
+	Command    []string          `json:"command,omitempty"`
+	Args       []string          `json:"args,omitempty"`
+	WorkingDir string            `json:"workingDir,omitempty"`
+	Endpoint   string            `json:"endpoint,omitempty"`
+	Replicas   *int32            `json:"replicas,omitempty"`
+}
+
+// RelabelAction selects how a RelabelConfig rule applies.
+// +kubebuilder:validation:Enum=replace;keep;drop;hashmod;labelmap;labeldrop;labelkeep;lowercase;uppercase
+type RelabelAction string
+
+const (
+	RelabelReplace   RelabelAction = "replace"
+	RelabelKeep      RelabelAction = "keep"
+	RelabelDrop      RelabelAction = "drop"
+	RelabelHashmod   RelabelAction = "hashmod"
+	RelabelLabelMap  RelabelAction = "labelmap"
+	RelabelLabelDrop RelabelAction = "labeldrop"
+	RelabelLabelKeep RelabelAction = "labelkeep"
+	RelabelLowercase RelabelAction = "lowercase"
+	RelabelUppercase RelabelAction = "uppercase"
+)
+
+// RelabelConfig is one rule in a Prometheus-inspired relabel pipeline.
+type RelabelConfig struct {
+	SourceLabels []string      `json:"sourceLabels,omitempty"`
+	Separator    string        `json:"separator,omitempty"`
+	Regex        string        `json:"regex,omitempty"`
+	Modulus      uint64        `json:"modulus,omitempty"`
+	TargetLabel  string        `json:"targetLabel,omitempty"`
+	Replacement  string        `json:"replacement,omitempty"`
+	Action       RelabelAction `json:"action,omitempty"`
+}
+
+// TemplateMetadata defines template metadata
+type TemplateMetadata struct {
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// DiscoveryFilters limits discovered providers
+type DiscoveryFilters struct {
+	IncludePatterns []string `json:"includePatterns,omitempty"`
+	ExcludePatterns []string `json:"excludePatterns,omitempty"`
+	MaxProviders    *int32   `json:"maxProviders,omitempty"`
+}
+
+// OwnershipConfig defines ownership settings
+type OwnershipConfig struct {
+	Controller    *bool `json:"controller,omitempty"`
+	BlockDeletion bool  `json:"blockDeletion,omitempty"`
+}
+
+// Condition type names set on MCPDiscoverySourceStatus.Conditions.
+const (
+	ConditionConsulReachable = "ConsulReachable"
+	ConditionDNSResolvable   = "DNSResolvable"
+	ConditionDrifted         = "Drifted"
+)
+
+// MCPDiscoverySourceStatus defines the observed state of MCPDiscoverySource
+type MCPDiscoverySourceStatus struct {
+	DiscoveredCount int32 `json:"discoveredCount,omitempty"`
+	ManagedCount    int32 `json:"managedCount,omitempty"`
+	DriftedCount    int32 `json:"driftedCount,omitempty"`
+
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// LastSyncDuration is how long the last sync took
+	LastSyncDuration metav1.Duration `json:"lastSyncDuration,omitempty"`
+
+	LastSyncError string       `json:"lastSyncError,omitempty"`
+	NextSyncTime  *metav1.Time `json:"nextSyncTime,omitempty"`
+
+	DiscoveredProviders []DiscoveredProvider `json:"discoveredProviders,omitempty"`
+	DriftedProviders    []DriftedProvider    `json:"driftedProviders,omitempty"`
+
+	ObservedGeneration int64       `json:"observedGeneration,omitempty"`
+	Conditions         []Condition `json:"conditions,omitempty"`
+}
+
+// DriftedProvider records one managed MCPProvider whose live spec no
+// longer matches what ProviderTemplate + RelabelConfigs would currently
+// produce for it.
+type DriftedProvider struct {
+	Name            string      `json:"name"`
+	Namespace       string      `json:"namespace"`
+	Fields          []string    `json:"fields,omitempty"`
+	FirstObservedAt metav1.Time `json:"firstObservedAt,omitempty"`
+}
+
+// DiscoveredProvider describes a discovered provider
+type DiscoveredProvider struct {
+	Name         string            `json:"name"`
+	Source       string            `json:"source"`
+	DiscoveredAt metav1.Time       `json:"discoveredAt,omitempty"`
+	Managed      bool              `json:"managed,omitempty"`
+	Error        string            `json:"error,omitempty"`
+	Topology     *EndpointTopology `json:"topology,omitempty"`
+}
+
+// EndpointTopology is the node/zone placement of a discovered endpoint address.
+type EndpointTopology struct {
+	Zone     string `json:"zone,omitempty"`
+	NodeName string `json:"nodeName,omitempty"`
+}
+
+// Condition represents a condition of a resource
+type Condition struct {
+	Type               string                 `json:"type"`
+	Status             metav1.ConditionStatus `json:"status"`
+	LastTransitionTime metav1.Time            `json:"lastTransitionTime,omitempty"`
+	Reason             string                 `json:"reason,omitempty"`
+	Message            string                 `json:"message,omitempty"`
+	ObservedGeneration int64                  `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:conversion:hub
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="Mode",type=string,JSONPath=`.spec.mode`
+// +kubebuilder:printcolumn:name="Discovered",type=integer,JSONPath=`.status.discoveredCount`
+// +kubebuilder:printcolumn:name="Drifted",type=integer,JSONPath=`.status.driftedCount`,priority=1
+// +kubebuilder:printcolumn:name="Last Sync",type=date,JSONPath=`.status.lastSyncTime`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:resource:shortName=mcpds;discoverysource,categories=mcp
+
+// MCPDiscoverySource is the Schema for the mcpdiscoverysources API
+type MCPDiscoverySource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MCPDiscoverySourceSpec   `json:"spec,omitempty"`
+	Status MCPDiscoverySourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MCPDiscoverySourceList contains a list of MCPDiscoverySource
+type MCPDiscoverySourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MCPDiscoverySource `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MCPDiscoverySource{}, &MCPDiscoverySourceList{})
+}
+
+// Hub marks MCPDiscoverySource as the conversion hub, the version every
+// other version's ConvertTo/ConvertFrom round-trips through.
+func (*MCPDiscoverySource) Hub() {}
+
+var _ conversion.Hub = &MCPDiscoverySource{}
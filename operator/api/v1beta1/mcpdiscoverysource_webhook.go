@@ -0,0 +1,16 @@
+package v1beta1
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// SetupWebhookWithManager registers this version with the manager's webhook
+// server. Since MCPDiscoverySource is this API group's conversion hub and
+// v1alpha1.MCPDiscoverySource implements conversion.Convertible, registering
+// either version here is enough for controller-runtime to also serve the
+// /convert endpoint the apiserver calls to translate between them.
+func (r *MCPDiscoverySource) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
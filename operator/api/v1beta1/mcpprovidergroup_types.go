@@ -0,0 +1,496 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LoadBalancingStrategy defines the load balancing algorithm
+// +kubebuilder:validation:Enum=RoundRobin;LeastConnections;Random;Weighted;Failover;WeightedLeastRequest
+type LoadBalancingStrategy string
+
+const (
+	StrategyRoundRobin           LoadBalancingStrategy = "RoundRobin"
+	StrategyLeastConnections     LoadBalancingStrategy = "LeastConnections"
+	StrategyRandom               LoadBalancingStrategy = "Random"
+	StrategyWeighted             LoadBalancingStrategy = "Weighted"
+	StrategyFailover             LoadBalancingStrategy = "Failover"
+	StrategyWeightedLeastRequest LoadBalancingStrategy = "WeightedLeastRequest"
+)
+
+// RetryCondition names a response class that triggers a group-level retry.
+// +kubebuilder:validation:Enum=Timeout;ConnectionError;5xx;GatewayError;Reset
+type RetryCondition string
+
+const (
+	RetryOnTimeout         RetryCondition = "Timeout"
+	RetryOnConnectionError RetryCondition = "ConnectionError"
+	RetryOn5xx             RetryCondition = "5xx"
+	RetryOnGatewayError    RetryCondition = "GatewayError"
+	RetryOnReset           RetryCondition = "Reset"
+)
+
+// SessionAffinityType selects how a group sticks a caller to a member.
+// +kubebuilder:validation:Enum=ClientIP;Header
+type SessionAffinityType string
+
+const (
+	SessionAffinityClientIP SessionAffinityType = "ClientIP"
+	SessionAffinityHeader   SessionAffinityType = "Header"
+)
+
+// FailoverMode replaces v1alpha1's FailoverConfig.Enabled *bool with a
+// required tri-state: Off disables failover, Retry is the v1alpha1 default
+// behavior, and Hedged additionally fires parallel speculative requests to
+// backup members instead of waiting out a failure before retrying.
+// +kubebuilder:validation:Enum=Off;Retry;Hedged
+type FailoverMode string
+
+const (
+	FailoverOff    FailoverMode = "Off"
+	FailoverRetry  FailoverMode = "Retry"
+	FailoverHedged FailoverMode = "Hedged"
+)
+
+// MCPProviderGroupSpec defines the desired state of MCPProviderGroup
+type MCPProviderGroupSpec struct {
+	// Selector selects MCPProviders to include in the group
+	// +kubebuilder:validation:Required
+	Selector *metav1.LabelSelector `json:"selector"`
+
+	// Strategy is the load balancing strategy
+	// +kubebuilder:default=RoundRobin
+	Strategy LoadBalancingStrategy `json:"strategy,omitempty"`
+
+	// Failover configures failover behavior
+	// +optional
+	Failover *FailoverConfig `json:"failover,omitempty"`
+
+	// HealthPolicy defines group health requirements
+	// +optional
+	HealthPolicy *HealthPolicy `json:"healthPolicy,omitempty"`
+
+	// SessionAffinity configures session stickiness
+	// +optional
+	SessionAffinity *SessionAffinityConfig `json:"sessionAffinity,omitempty"`
+
+	// CircuitBreaker configures group-level circuit breaker
+	// +optional
+	CircuitBreaker *GroupCircuitBreakerConfig `json:"circuitBreaker,omitempty"`
+
+	// SlowStartWindow is how long a newly-Ready member's effective weight
+	// takes to ramp linearly from 0 to its configured Weight under the
+	// WeightedLeastRequest strategy, so a cold container isn't flooded the
+	// instant it turns Ready. Ignored by other strategies.
+	// +kubebuilder:default="30s"
+	SlowStartWindow string `json:"slowStartWindow,omitempty"`
+
+	// AdaptiveConcurrency bounds how many in-flight requests the group
+	// router sends to each member using a Gradient2 limiter, the same
+	// knob MCPProviderSpec.AdaptiveConcurrency applies per-Pod but scoped
+	// to the group's view of a member instead. Unset leaves routing
+	// unlimited.
+	// +optional
+	AdaptiveConcurrency *AdaptiveConcurrencyConfig `json:"adaptiveConcurrency,omitempty"`
+}
+
+// AdaptiveConcurrencyConfig configures a Netflix-style Gradient2 limiter:
+// it tracks an EWMA of the best (lowest) observed RTT alongside a
+// short-window EWMA of current RTT, and shrinks or grows the concurrency
+// limit as their ratio - the "gradient" - falls or recovers, the same
+// back-pressure signal Little's Law formalizes as concurrency =
+// throughput * latency.
+type AdaptiveConcurrencyConfig struct {
+	// Enabled enables the limiter. Disabled members/providers are
+	// unlimited.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// MinLimit is the smallest the concurrency limit may shrink to.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	MinLimit int32 `json:"minLimit,omitempty"`
+
+	// MaxLimit is the largest the concurrency limit may grow to.
+	// +kubebuilder:default=256
+	// +kubebuilder:validation:Minimum=1
+	MaxLimit int32 `json:"maxLimit,omitempty"`
+
+	// QueueSize is added to the gradient-scaled limit on every update,
+	// the same small constant headroom Netflix's concurrency-limits
+	// library adds so the limit can still grow after a perfectly flat
+	// gradient.
+	// +kubebuilder:default=4
+	// +kubebuilder:validation:Minimum=0
+	QueueSize int32 `json:"queueSize,omitempty"`
+}
+
+// FailoverConfig defines failover settings
+type FailoverConfig struct {
+	// Mode selects whether failover is disabled, retries sequentially, or
+	// hedges by racing backup members in parallel.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:default=Retry
+	Mode FailoverMode `json:"mode"`
+
+	// MaxRetries is the maximum retry attempts
+	// +kubebuilder:default=2
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10
+	MaxRetries int32 `json:"maxRetries,omitempty"`
+
+	// RetryDelay is the delay between retries
+	// +kubebuilder:default="1s"
+	RetryDelay metav1.Duration `json:"retryDelay,omitempty"`
+
+	// RetryOn lists conditions that trigger retry
+	// +kubebuilder:default={"Timeout","ConnectionError"}
+	RetryOn []RetryCondition `json:"retryOn,omitempty"`
+}
+
+// HealthPolicy defines group health requirements
+type HealthPolicy struct {
+	// MinHealthyPercentage is minimum healthy providers percentage
+	// +kubebuilder:default=50
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MinHealthyPercentage int32 `json:"minHealthyPercentage,omitempty"`
+
+	// MinHealthyCount is minimum healthy provider count (overrides percentage)
+	// +optional
+	MinHealthyCount *int32 `json:"minHealthyCount,omitempty"`
+
+	// UnhealthyThreshold is consecutive failures before marking unhealthy
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=1
+	UnhealthyThreshold int32 `json:"unhealthyThreshold,omitempty"`
+}
+
+// SessionAffinityConfig defines session affinity settings
+type SessionAffinityConfig struct {
+	// Enabled enables session affinity
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Type is the affinity type (ClientIP or Header)
+	// +kubebuilder:default=ClientIP
+	Type SessionAffinityType `json:"type,omitempty"`
+
+	// Header is the header name for Header affinity type
+	// +optional
+	Header string `json:"header,omitempty"`
+
+	// TTL is the session TTL
+	// +kubebuilder:default="10m"
+	TTL metav1.Duration `json:"ttl,omitempty"`
+}
+
+// GroupCircuitBreakerConfig defines group-level circuit breaker
+type GroupCircuitBreakerConfig struct {
+	// Enabled enables group circuit breaker
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// FailureThreshold before opening circuit
+	// +kubebuilder:default=10
+	FailureThreshold int32 `json:"failureThreshold,omitempty"`
+
+	// ResetTimeout before attempting recovery
+	// +kubebuilder:default="1m"
+	ResetTimeout metav1.Duration `json:"resetTimeout,omitempty"`
+
+	// HalfOpenMaxProbes caps how many requests are let through at once
+	// while the breaker is HalfOpen, the way Envoy limits probe traffic
+	// before fully trusting a recovering group.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	HalfOpenMaxProbes int32 `json:"halfOpenMaxProbes,omitempty"`
+
+	// SuccessThreshold is how many consecutive HalfOpen probe successes
+	// are required before the breaker closes again. Falling short of it
+	// before HalfOpenMaxProbes probes finish reopens the breaker.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	SuccessThreshold int32 `json:"successThreshold,omitempty"`
+
+	// OutlierDetection ejects individual members from routing instead of
+	// tripping the whole group, the way Envoy's outlier detection runs
+	// alongside its circuit breaker.
+	// +optional
+	OutlierDetection *OutlierDetectionConfig `json:"outlierDetection,omitempty"`
+}
+
+// OutlierDetectionConfig configures per-member ejection alongside the
+// group-level circuit breaker.
+type OutlierDetectionConfig struct {
+	// Consecutive5xxErrors is how many consecutive 5xx responses from a
+	// member trigger ejection.
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	Consecutive5xxErrors int32 `json:"consecutive5xxErrors,omitempty"`
+
+	// ConsecutiveGatewayErrors is how many consecutive gateway failures
+	// (connection refused, timeout) from a member trigger ejection.
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	ConsecutiveGatewayErrors int32 `json:"consecutiveGatewayErrors,omitempty"`
+
+	// SuccessRateStdevFactor ejects a member whose success rate falls
+	// this many standard deviations below the group's mean success rate.
+	// +kubebuilder:default=2
+	// +kubebuilder:validation:Minimum=1
+	SuccessRateStdevFactor int32 `json:"successRateStdevFactor,omitempty"`
+
+	// BaseEjectionTime is the ejection duration for a member's first
+	// ejection; each subsequent ejection multiplies it by the member's
+	// ejection count, the same escalating-penalty Envoy applies.
+	// +kubebuilder:default="30s"
+	BaseEjectionTime string `json:"baseEjectionTime,omitempty"`
+
+	// MaxEjectionPercent caps the percentage of the group that may be
+	// ejected at once, so outlier detection can't eject an entire group
+	// into having zero routable members.
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MaxEjectionPercent int32 `json:"maxEjectionPercent,omitempty"`
+}
+
+// GroupCircuitState is the current state of a group's or member's circuit
+// breaker.
+// +kubebuilder:validation:Enum=Closed;Open;HalfOpen
+type GroupCircuitState string
+
+const (
+	GroupCircuitClosed   GroupCircuitState = "Closed"
+	GroupCircuitOpen     GroupCircuitState = "Open"
+	GroupCircuitHalfOpen GroupCircuitState = "HalfOpen"
+)
+
+// MCPProviderGroupStatus defines the observed state of MCPProviderGroup
+type MCPProviderGroupStatus struct {
+	// ProviderCount is total providers in group
+	ProviderCount int32 `json:"providerCount,omitempty"`
+
+	// ReadyCount is the number of ready providers
+	ReadyCount int32 `json:"readyCount,omitempty"`
+
+	// DegradedCount is the number of degraded providers
+	DegradedCount int32 `json:"degradedCount,omitempty"`
+
+	// ColdCount is the number of cold providers
+	ColdCount int32 `json:"coldCount,omitempty"`
+
+	// DeadCount is the number of dead providers
+	DeadCount int32 `json:"deadCount,omitempty"`
+
+	// ActiveStrategy is the currently active strategy
+	ActiveStrategy string `json:"activeStrategy,omitempty"`
+
+	// CircuitState is the group-level circuit breaker's current state.
+	// Empty while CircuitBreaker is unset or disabled.
+	// +optional
+	CircuitState GroupCircuitState `json:"circuitState,omitempty"`
+
+	// Providers contains provider member details
+	Providers []ProviderMemberStatus `json:"providers,omitempty"`
+
+	// RampingProviders lists members still inside SlowStartWindow, i.e.
+	// whose RampFraction is below 1. Populated only under the
+	// WeightedLeastRequest strategy.
+	// +optional
+	RampingProviders []ProviderMemberStatus `json:"rampingProviders,omitempty"`
+
+	// ObservedGeneration is the generation observed by controller
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// ProviderMemberStatus defines the status of a group member
+type ProviderMemberStatus struct {
+	// Name of the provider
+	Name string `json:"name"`
+
+	// Namespace of the provider
+	Namespace string `json:"namespace"`
+
+	// State of the provider
+	State string `json:"state,omitempty"`
+
+	// Weight for weighted load balancing
+	Weight int32 `json:"weight,omitempty"`
+
+	// ActiveConnections for least connections strategy
+	ActiveConnections int32 `json:"activeConnections,omitempty"`
+
+	// LastHealthCheck time
+	LastHealthCheck *metav1.Time `json:"lastHealthCheck,omitempty"`
+
+	// CircuitState is this member's outlier-detection ejection state:
+	// Closed (routable), or Open while EjectedUntil hasn't passed yet.
+	// HalfOpen is unused at member level - outlier detection ejects and
+	// un-ejects, it doesn't probe the way the group breaker does.
+	// +optional
+	CircuitState GroupCircuitState `json:"circuitState,omitempty"`
+
+	// EjectedUntil is when this member's outlier-detection ejection
+	// lifts. Unset when the member isn't ejected.
+	// +optional
+	EjectedUntil *metav1.Time `json:"ejectedUntil,omitempty"`
+
+	// RampFraction is how far this member is through SlowStartWindow under
+	// the WeightedLeastRequest strategy, from 0 (just turned Ready) to 1
+	// (fully ramped, or not ramping at all). Unused by other strategies.
+	// +optional
+	RampFraction float64 `json:"rampFraction,omitempty"`
+
+	// Limit is this member's current AdaptiveConcurrency limit. Unset
+	// while AdaptiveConcurrency is disabled.
+	// +optional
+	Limit int32 `json:"limit,omitempty"`
+
+	// InFlight is this member's current in-flight request count under
+	// AdaptiveConcurrency.
+	// +optional
+	InFlight int32 `json:"inFlight,omitempty"`
+
+	// RttP50 is this member's short-window RTT EWMA, in milliseconds - an
+	// exponentially-weighted approximation of the median, not a tracked
+	// percentile.
+	// +optional
+	RttP50 int64 `json:"rttP50,omitempty"`
+}
+
+// Condition represents a condition of a resource
+type Condition struct {
+	// Type of condition
+	Type string `json:"type"`
+
+	// Status of the condition
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	Status metav1.ConditionStatus `json:"status"`
+
+	// LastTransitionTime is the last time the condition transitioned
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Reason is a machine-readable reason
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human-readable description
+	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration represents the generation observed
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Strategy",type=string,JSONPath=`.spec.strategy`
+// +kubebuilder:printcolumn:name="Providers",type=integer,JSONPath=`.status.providerCount`
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.readyCount`
+// +kubebuilder:printcolumn:name="Degraded",type=integer,JSONPath=`.status.degradedCount`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:resource:shortName=mcppg;providergroup,categories=mcp
+
+// MCPProviderGroup is the Schema for the mcpprovidergroups API. It is both
+// the storage version (+kubebuilder:storageversion) and the conversion hub
+// for this group: v1alpha1 converts to and from this type, and any future
+// version converts through it instead of directly to/from v1alpha1.
+//
+// Existing v1alpha1 objects persisted in etcd are upgraded to v1beta1
+// storage lazily, on next write, unless annotated for the
+// storage-version-migrator controller to rewrite eagerly:
+//
+//	storage.k8s.io/migrated-version: v1beta1
+//
+// +kubebuilder:conversion:hub
+type MCPProviderGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MCPProviderGroupSpec   `json:"spec,omitempty"`
+	Status MCPProviderGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MCPProviderGroupList contains a list of MCPProviderGroup
+type MCPProviderGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MCPProviderGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MCPProviderGroup{}, &MCPProviderGroupList{})
+}
+
+// Hub marks MCPProviderGroup as the conversion hub version, satisfying
+// sigs.k8s.io/controller-runtime/pkg/conversion.Hub. Spoke versions (e.g.
+// v1alpha1.MCPProviderGroup) implement conversion.Convertible instead.
+func (*MCPProviderGroup) Hub() {}
+
+// Helper methods
+
+// IsFailoverEnabled returns true if failover is enabled
+func (g *MCPProviderGroup) IsFailoverEnabled() bool {
+	if g.Spec.Failover == nil {
+		return true // Default enabled
+	}
+	return g.Spec.Failover.Mode != FailoverOff
+}
+
+// GetMaxRetries returns the maximum retry count
+func (g *MCPProviderGroup) GetMaxRetries() int32 {
+	if g.Spec.Failover == nil {
+		return 2 // Default
+	}
+	return g.Spec.Failover.MaxRetries
+}
+
+// IsHealthy returns true if the group meets health requirements
+func (s *MCPProviderGroupStatus) IsHealthy(policy *HealthPolicy) bool {
+	if s.ProviderCount == 0 {
+		return false
+	}
+
+	if policy == nil {
+		return s.ReadyCount > 0
+	}
+
+	// Check minimum count first
+	if policy.MinHealthyCount != nil {
+		return s.ReadyCount >= *policy.MinHealthyCount
+	}
+
+	// Check percentage
+	percentage := (s.ReadyCount * 100) / s.ProviderCount
+	return percentage >= policy.MinHealthyPercentage
+}
+
+// SetCondition sets or updates a condition
+func (s *MCPProviderGroupStatus) SetCondition(condType string, status metav1.ConditionStatus, reason, message string) {
+	now := metav1.Now()
+
+	for i, c := range s.Conditions {
+		if c.Type == condType {
+			if c.Status != status {
+				s.Conditions[i].LastTransitionTime = now
+			}
+			s.Conditions[i].Status = status
+			s.Conditions[i].Reason = reason
+			s.Conditions[i].Message = message
+			return
+		}
+	}
+
+	s.Conditions = append(s.Conditions, Condition{
+		Type:               condType,
+		Status:             status,
+		LastTransitionTime: now,
+		Reason:             reason,
+		Message:            message,
+	})
+}
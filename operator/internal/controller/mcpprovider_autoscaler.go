@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+	"github.com/mapyr/mcp-hangar/operator/pkg/hangar"
+	"github.com/mapyr/mcp-hangar/operator/pkg/provider"
+)
+
+// reconcileAutoscaling evaluates Spec.Autoscaling against live Hangar
+// metrics and, if the recommendation differs from the current replica
+// count and the relevant stabilization window has passed, patches
+// Spec.Replicas directly - the same way core Kubernetes HPA drives a
+// target resource's spec.replicas, so every existing GetReplicas() caller
+// keeps working unmodified. A nil Autoscaling leaves Replicas exactly as
+// the user set it.
+func (r *MCPProviderReconciler) reconcileAutoscaling(ctx context.Context, mcpProvider *mcpv1alpha1.MCPProvider) error {
+	autoscaling := mcpProvider.Spec.Autoscaling
+	if autoscaling == nil {
+		return nil
+	}
+
+	logger := log.FromContext(ctx)
+	current := mcpProvider.GetReplicas()
+
+	desired := current
+	observed := false
+	for _, metric := range autoscaling.Metrics {
+		value, err := r.observeMetric(ctx, mcpProvider, metric)
+		if err != nil {
+			logger.Error(err, "Failed to observe autoscaling metric", "type", metric.Type)
+			continue
+		}
+		if value == nil {
+			continue
+		}
+		if recommended := provider.RecommendReplicas(current, *value, metric.Target); !observed || recommended > desired {
+			desired = recommended
+			observed = true
+		}
+	}
+
+	desired = provider.ClampReplicas(autoscaling, desired)
+	mcpProvider.Status.DesiredReplicas = desired
+
+	if desired == current {
+		return nil
+	}
+
+	stabilization := provider.ResolveScaleDownStabilization(autoscaling)
+	if desired > current {
+		stabilization = provider.ResolveScaleUpStabilization(autoscaling)
+	}
+	if last := mcpProvider.Status.LastScaleTime; last != nil && time.Since(last.Time) < stabilization {
+		return nil
+	}
+
+	logger.Info("Autoscaling replicas", "from", current, "to", desired)
+	mcpProvider.Spec.Replicas = &desired
+	now := metav1.Now()
+	mcpProvider.Status.LastScaleTime = &now
+
+	return r.Update(ctx, mcpProvider)
+}
+
+// observeMetric reads the current value of a single MetricSpec. Resource
+// and External sources are accepted for shape-parity with core HPA's
+// MetricSpec but aren't backed by a metrics-server or external-metrics
+// adapter client in this codebase, so they return (nil, nil) rather than
+// an error - they're silently skipped by reconcileAutoscaling until such a
+// client exists.
+func (r *MCPProviderReconciler) observeMetric(ctx context.Context, mcpProvider *mcpv1alpha1.MCPProvider, metric mcpv1alpha1.MetricSpec) (*float64, error) {
+	switch metric.Type {
+	case mcpv1alpha1.MetricSourceMCPToolConcurrency, mcpv1alpha1.MetricSourceMCPQueueDepth, mcpv1alpha1.MetricSourceMCPTokensPerSecond:
+		if r.HangarClient == nil {
+			return nil, nil
+		}
+		metrics, err := r.HangarClient.GetProviderMetrics(ctx, mcpProvider.Name, mcpProvider.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		return metricValue(metric.Type, metrics), nil
+	default:
+		return nil, nil
+	}
+}
+
+func metricValue(metricType mcpv1alpha1.MetricSourceType, metrics *hangar.ProviderMetrics) *float64 {
+	var value float64
+	switch metricType {
+	case mcpv1alpha1.MetricSourceMCPToolConcurrency:
+		value = float64(metrics.ActiveToolCalls)
+	case mcpv1alpha1.MetricSourceMCPQueueDepth:
+		value = float64(metrics.QueueDepth)
+	case mcpv1alpha1.MetricSourceMCPTokensPerSecond:
+		value = metrics.TokensPerSecond
+	default:
+		return nil
+	}
+	return &value
+}
@@ -4,8 +4,10 @@ package controller
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,7 +17,11 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
 	"github.com/mapyr/mcp-hangar/operator/pkg/hangar"
@@ -32,24 +38,41 @@ const (
 	ConditionProgressing = "Progressing"
 	ConditionDegraded    = "Degraded"
 	ConditionAvailable   = "Available"
-
-	// Requeue intervals
+	ConditionInitialized = "Initialized"
+	ConditionDraining    = "Draining"
+	// ConditionCanaryAnalysis reports whether a Canary rollout's
+	// new-template Pods are still within their AnalysisInterval
+	// observation window.
+	ConditionCanaryAnalysis = "CanaryAnalysis"
+
+	// Requeue intervals. readyRequeueAfter is now mostly a safety net for
+	// Ready providers: runHealthWatch enqueues them immediately on a Hangar
+	// health transition, so this interval only matters while that watch is
+	// down.
 	defaultRequeueAfter = 30 * time.Second
 	errorRequeueAfter   = 10 * time.Second
 	readyRequeueAfter   = 5 * time.Minute
 	coldRequeueAfter    = 10 * time.Minute
+	probeRequeueAfter   = 15 * time.Second
 
 	// Event reasons
-	ReasonCreated   = "Created"
-	ReasonUpdated   = "Updated"
-	ReasonDeleted   = "Deleted"
-	ReasonFailed    = "Failed"
-	ReasonReady     = "Ready"
-	ReasonDegraded  = "Degraded"
-	ReasonStarting  = "Starting"
-	ReasonStopping  = "Stopping"
-	ReasonHealthy   = "Healthy"
-	ReasonUnhealthy = "Unhealthy"
+	ReasonCreated             = "Created"
+	ReasonUpdated             = "Updated"
+	ReasonDeleted             = "Deleted"
+	ReasonFailed              = "Failed"
+	ReasonReady               = "Ready"
+	ReasonDegraded            = "Degraded"
+	ReasonStarting            = "Starting"
+	ReasonStopping            = "Stopping"
+	ReasonHealthy             = "Healthy"
+	ReasonUnhealthy           = "Unhealthy"
+	ReasonProbeFailed         = "ProbeFailed"
+	ReasonGracePeriodTooShort = "GracePeriodTooShort"
+	ReasonImagePullFailed     = "ImagePullFailed"
+	ReasonCircuitOpen         = "CircuitOpen"
+	ReasonHalfOpen            = "HalfOpen"
+	ReasonDraining            = "Draining"
+	ReasonDrained             = "Drained"
 )
 
 // MCPProviderReconciler reconciles a MCPProvider object
@@ -90,20 +113,27 @@ func DefaultReconcilerConfig() *ReconcilerConfig {
 // +kubebuilder:rbac:groups=mcp-hangar.io,resources=mcpproviders/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=mcp-hangar.io,resources=mcpproviders/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile performs the reconciliation loop for MCPProvider
 func (r *MCPProviderReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 	startTime := time.Now()
 
+	ctx, endSpan := metrics.StartReconcileSpan(ctx, "mcpprovider")
+	defer endSpan()
+
 	logger.Info("Reconciling MCPProvider", "namespacedName", req.NamespacedName)
+	var providerName string
 	defer func() {
 		duration := time.Since(startTime)
-		metrics.ReconcileDuration.WithLabelValues("mcpprovider").Observe(duration.Seconds())
+		metrics.ObserveReconcileDuration(ctx, "mcpprovider", providerName, duration.Seconds())
 	}()
 
 	// Fetch the MCPProvider instance
@@ -114,17 +144,18 @@ func (r *MCPProviderReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			return ctrl.Result{}, nil
 		}
 		logger.Error(err, "Failed to get MCPProvider")
-		metrics.ReconcileTotal.WithLabelValues("mcpprovider", "error").Inc()
+		metrics.RecordReconcile(ctx, "mcpprovider", "error")
 		return ctrl.Result{}, err
 	}
+	providerName = mcpProvider.Name
 
 	// Handle deletion
 	if !mcpProvider.ObjectMeta.DeletionTimestamp.IsZero() {
 		result, err := r.reconcileDelete(ctx, mcpProvider)
 		if err != nil {
-			metrics.ReconcileTotal.WithLabelValues("mcpprovider", "error").Inc()
+			metrics.RecordReconcile(ctx, "mcpprovider", "error")
 		} else {
-			metrics.ReconcileTotal.WithLabelValues("mcpprovider", "success").Inc()
+			metrics.RecordReconcile(ctx, "mcpprovider", "success")
 		}
 		return result, err
 	}
@@ -141,9 +172,9 @@ func (r *MCPProviderReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	// Main reconciliation logic
 	result, err := r.reconcileNormal(ctx, mcpProvider)
 	if err != nil {
-		metrics.ReconcileTotal.WithLabelValues("mcpprovider", "error").Inc()
+		metrics.RecordReconcile(ctx, "mcpprovider", "error")
 	} else {
-		metrics.ReconcileTotal.WithLabelValues("mcpprovider", "success").Inc()
+		metrics.RecordReconcile(ctx, "mcpprovider", "success")
 	}
 
 	return result, err
@@ -190,26 +221,77 @@ func (r *MCPProviderReconciler) reconcileContainerProvider(ctx context.Context,
 		return ctrl.Result{}, nil
 	}
 
-	// Build desired Pod spec
-	desiredPod, err := provider.BuildPodForProvider(mcpProvider)
+	if err := r.reconcileImagePullCredentials(ctx, mcpProvider); err != nil {
+		logger.Error(err, "Failed to materialize image pull credentials")
+		mcpProvider.Status.SetCondition(ConditionReady, metav1.ConditionFalse, "ImagePullCredentialsFailed", err.Error())
+		if err := r.Status().Update(ctx, mcpProvider); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: errorRequeueAfter}, nil
+	}
+
+	if err := r.reconcileAutoscaling(ctx, mcpProvider); err != nil {
+		logger.Error(err, "Failed to reconcile autoscaling")
+	}
+
+	// Build desired workload (Pod, Deployment, or StatefulSet)
+	desired, err := provider.BuildWorkloadForProvider(mcpProvider)
 	if err != nil {
-		logger.Error(err, "Failed to build Pod spec")
-		mcpProvider.Status.SetCondition(ConditionReady, metav1.ConditionFalse, "PodBuildFailed", err.Error())
+		logger.Error(err, "Failed to build workload")
+		mcpProvider.Status.SetCondition(ConditionReady, metav1.ConditionFalse, "WorkloadBuildFailed", err.Error())
 		if err := r.Status().Update(ctx, mcpProvider); err != nil {
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{RequeueAfter: errorRequeueAfter}, nil
 	}
 
+	if warning := provider.LifecyclePreStopTimeoutWarning(mcpProvider); warning != "" {
+		r.Recorder.Event(mcpProvider, corev1.EventTypeWarning, ReasonGracePeriodTooShort, warning)
+	}
+
 	// Set owner reference
-	if err := controllerutil.SetControllerReference(mcpProvider, desiredPod, r.Scheme); err != nil {
+	if err := controllerutil.SetControllerReference(mcpProvider, desired, r.Scheme); err != nil {
 		return ctrl.Result{}, err
 	}
 
-	// Check if Pod exists
+	switch workload := desired.(type) {
+	case *corev1.Pod:
+		if mcpProvider.GetReplicas() > 1 {
+			pods, err := provider.BuildPodsForProvider(mcpProvider)
+			if err != nil {
+				logger.Error(err, "Failed to build Pod set")
+				mcpProvider.Status.SetCondition(ConditionReady, metav1.ConditionFalse, "WorkloadBuildFailed", err.Error())
+				if err := r.Status().Update(ctx, mcpProvider); err != nil {
+					return ctrl.Result{}, err
+				}
+				return ctrl.Result{RequeueAfter: errorRequeueAfter}, nil
+			}
+			for _, pod := range pods {
+				if err := controllerutil.SetControllerReference(mcpProvider, pod, r.Scheme); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+			return r.reconcilePodSet(ctx, mcpProvider, pods)
+		}
+		return r.reconcilePod(ctx, mcpProvider, workload)
+	case *appsv1.Deployment:
+		return r.reconcileScalableWorkload(ctx, mcpProvider, workload)
+	case *appsv1.StatefulSet:
+		if err := r.reconcileHeadlessService(ctx, mcpProvider); err != nil {
+			return ctrl.Result{}, err
+		}
+		return r.reconcileScalableWorkload(ctx, mcpProvider, workload)
+	default:
+		return ctrl.Result{}, fmt.Errorf("unsupported workload type %T", desired)
+	}
+}
+
+// reconcilePod handles the WorkloadKindPod path: create the Pod if it's
+// missing, otherwise sync its status.
+func (r *MCPProviderReconciler) reconcilePod(ctx context.Context, mcpProvider *mcpv1alpha1.MCPProvider, desiredPod *corev1.Pod) (ctrl.Result, error) {
 	existingPod := &corev1.Pod{}
 	podKey := types.NamespacedName{Name: desiredPod.Name, Namespace: desiredPod.Namespace}
-	err = r.Get(ctx, podKey, existingPod)
+	err := r.Get(ctx, podKey, existingPod)
 
 	if errors.IsNotFound(err) {
 		return r.handlePodNotFound(ctx, mcpProvider, desiredPod)
@@ -284,9 +366,16 @@ func (r *MCPProviderReconciler) syncPodStatus(ctx context.Context, mcpProvider *
 		requeueAfter = r.handlePodRunning(ctx, mcpProvider, pod)
 
 	case corev1.PodPending:
+		syncInitializedCondition(mcpProvider, pod)
 		mcpProvider.Status.State = mcpv1alpha1.ProviderStateInitializing
 		mcpProvider.Status.ReadyReplicas = 0
-		mcpProvider.Status.SetCondition(ConditionProgressing, metav1.ConditionTrue, "PodPending", "Pod is pending")
+
+		if reason, message := provider.ImagePullFailureReason(pod); reason != "" {
+			requeueAfter = r.handleImagePullFailure(mcpProvider, reason, message)
+		} else {
+			mcpProvider.Status.PullStatus = nil
+			mcpProvider.Status.SetCondition(ConditionProgressing, metav1.ConditionTrue, "PodPending", "Pod is pending")
+		}
 		metrics.SetProviderState(mcpProvider.Namespace, mcpProvider.Name, "Initializing")
 
 	case corev1.PodFailed:
@@ -299,7 +388,7 @@ func (r *MCPProviderReconciler) syncPodStatus(ctx context.Context, mcpProvider *
 		now := metav1.Now()
 		mcpProvider.Status.LastStoppedAt = &now
 
-		if err := r.Delete(ctx, pod); err != nil && !errors.IsNotFound(err) {
+		if err := r.gracefulDeletePod(ctx, mcpProvider, pod); err != nil {
 			return ctrl.Result{}, err
 		}
 		metrics.SetProviderState(mcpProvider.Namespace, mcpProvider.Name, "Cold")
@@ -321,6 +410,8 @@ func (r *MCPProviderReconciler) syncPodStatus(ctx context.Context, mcpProvider *
 func (r *MCPProviderReconciler) handlePodRunning(ctx context.Context, mcpProvider *mcpv1alpha1.MCPProvider, pod *corev1.Pod) time.Duration {
 	logger := log.FromContext(ctx)
 
+	syncInitializedCondition(mcpProvider, pod)
+
 	// Check if all containers are ready
 	allReady := true
 	for _, cs := range pod.Status.ContainerStatuses {
@@ -333,6 +424,19 @@ func (r *MCPProviderReconciler) handlePodRunning(ctx context.Context, mcpProvide
 	if !allReady {
 		mcpProvider.Status.State = mcpv1alpha1.ProviderStateInitializing
 		mcpProvider.Status.ReadyReplicas = 0
+
+		// A container that's Running but not Ready has started and is
+		// failing its readiness (or gated startup) probe, rather than
+		// still being created - surface that distinctly and requeue
+		// sooner so the reconciler tracks probe outcomes, not just
+		// pod phase.
+		if reason, message := probeFailureReason(pod); reason != "" {
+			mcpProvider.Status.SetCondition(ConditionReady, metav1.ConditionFalse, reason, message)
+			mcpProvider.Status.SetCondition(ConditionProgressing, metav1.ConditionTrue, reason, message)
+			metrics.SetProviderState(mcpProvider.Namespace, mcpProvider.Name, "Initializing")
+			return probeRequeueAfter
+		}
+
 		mcpProvider.Status.SetCondition(ConditionProgressing, metav1.ConditionTrue, "ContainersStarting", "Waiting for containers to be ready")
 		metrics.SetProviderState(mcpProvider.Namespace, mcpProvider.Name, "Initializing")
 		return defaultRequeueAfter
@@ -356,17 +460,33 @@ func (r *MCPProviderReconciler) handlePodRunning(ctx context.Context, mcpProvide
 		metrics.ProviderToolsCount.WithLabelValues(mcpProvider.Namespace, mcpProvider.Name).Set(float64(len(tools)))
 	}
 
-	// Provider is ready
+	// Provider is ready. A successful Pod also closes the circuit breaker:
+	// whether this Pod came up on the first try or as a half-open retry,
+	// the failure streak resets and the cooldown is no longer relevant.
 	mcpProvider.Status.State = mcpv1alpha1.ProviderStateReady
 	mcpProvider.Status.ReadyReplicas = 1
-	mcpProvider.Status.AvailableReplicas = 1
 	mcpProvider.Status.ConsecutiveFailures = 0
+	mcpProvider.Status.CooldownUntil = nil
 	now := metav1.Now()
 	mcpProvider.Status.LastHealthCheck = &now
+	metrics.SetProviderCircuitState(mcpProvider.Namespace, mcpProvider.Name, "Closed")
 
 	mcpProvider.Status.SetCondition(ConditionReady, metav1.ConditionTrue, "ProviderReady", "Provider is ready")
 	mcpProvider.Status.SetCondition(ConditionProgressing, metav1.ConditionFalse, "Reconciled", "")
 	mcpProvider.Status.SetCondition(ConditionDegraded, metav1.ConditionFalse, "", "")
+
+	// Ready means the pod's probes pass right now; Available additionally
+	// requires it to have stayed ready for MinReadySeconds, so hangar
+	// routing decisions don't send traffic to a pod that just came up.
+	if remaining := minReadySecondsRemaining(pod, mcpProvider.Spec.MinReadySeconds); remaining > 0 {
+		mcpProvider.Status.AvailableReplicas = 0
+		mcpProvider.Status.SetCondition(ConditionAvailable, metav1.ConditionFalse, "NotAvailable",
+			fmt.Sprintf("pod must stay ready for %ds before it's considered available", mcpProvider.Spec.MinReadySeconds))
+		metrics.SetProviderState(mcpProvider.Namespace, mcpProvider.Name, "Ready")
+		return remaining
+	}
+
+	mcpProvider.Status.AvailableReplicas = 1
 	mcpProvider.Status.SetCondition(ConditionAvailable, metav1.ConditionTrue, "Available", "Provider is available")
 
 	r.Recorder.Event(mcpProvider, corev1.EventTypeNormal, ReasonReady, "Provider is ready")
@@ -375,14 +495,101 @@ func (r *MCPProviderReconciler) handlePodRunning(ctx context.Context, mcpProvide
 	return readyRequeueAfter
 }
 
+// syncInitializedCondition copies the Pod's own Initialized condition (set
+// by the kubelet once every init container, including any StartupProbe
+// gate, has completed) onto the MCPProvider so callers can tell "init
+// finished" apart from "containers are ready".
+func syncInitializedCondition(mcpProvider *mcpv1alpha1.MCPProvider, pod *corev1.Pod) {
+	for _, c := range pod.Status.Conditions {
+		if c.Type != corev1.PodInitialized {
+			continue
+		}
+		status := metav1.ConditionFalse
+		if c.Status == corev1.ConditionTrue {
+			status = metav1.ConditionTrue
+		}
+		reason := c.Reason
+		if reason == "" {
+			reason = "PodInitialized"
+		}
+		mcpProvider.Status.SetCondition(ConditionInitialized, status, reason, c.Message)
+		return
+	}
+}
+
+// probeFailureReason inspects a Pod's container statuses for a container
+// that is Running but not Ready, meaning it started successfully but is
+// currently failing its readiness (or startup-gated) probe. It returns an
+// empty reason if no such container is found, e.g. because the pod is
+// still being created.
+func probeFailureReason(pod *corev1.Pod) (reason, message string) {
+	for _, cs := range pod.Status.ContainerStatuses {
+		if !cs.Ready && cs.State.Running != nil {
+			return ReasonProbeFailed, fmt.Sprintf("container %q is running but failing its readiness/startup probe", cs.Name)
+		}
+	}
+	return "", ""
+}
+
+// handleImagePullFailure records a Pod stuck in ImagePullBackOff/ErrImagePull
+// on MCPProvider.Status.PullStatus and returns the exponential-backoff delay
+// the reconciler should wait before checking the Pod again.
+func (r *MCPProviderReconciler) handleImagePullFailure(mcpProvider *mcpv1alpha1.MCPProvider, reason, message string) time.Duration {
+	pullStatus := mcpProvider.Status.PullStatus
+	if pullStatus == nil {
+		pullStatus = &mcpv1alpha1.PullStatus{}
+		mcpProvider.Status.PullStatus = pullStatus
+	}
+	pullStatus.Attempts++
+	pullStatus.LastError = message
+	now := metav1.Now()
+	pullStatus.LastAttemptTime = &now
+
+	mcpProvider.Status.SetCondition(ConditionProgressing, metav1.ConditionTrue, reason, message)
+	r.Recorder.Event(mcpProvider, corev1.EventTypeWarning, ReasonImagePullFailed, message)
+
+	return provider.PullBackoffDelay(pullStatus.Attempts)
+}
+
+// minReadySecondsRemaining returns how much longer pod's Ready condition
+// must hold continuously before minReadySeconds have elapsed, mirroring
+// how the Deployment controller distinguishes Ready from Available. A
+// non-positive result means the pod is already available.
+func minReadySecondsRemaining(pod *corev1.Pod, minReadySeconds int32) time.Duration {
+	if minReadySeconds <= 0 {
+		return 0
+	}
+
+	required := time.Duration(minReadySeconds) * time.Second
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady && c.Status == corev1.ConditionTrue {
+			return required - time.Since(c.LastTransitionTime.Time)
+		}
+	}
+	return required
+}
+
 // handlePodFailed handles a failed Pod
 func (r *MCPProviderReconciler) handlePodFailed(ctx context.Context, mcpProvider *mcpv1alpha1.MCPProvider, pod *corev1.Pod) time.Duration {
 	logger := log.FromContext(ctx)
 
-	mcpProvider.Status.State = mcpv1alpha1.ProviderStateDead
+	// Already open: nothing changed since the last reconcile, just keep
+	// waiting out the remaining cooldown rather than re-counting this as
+	// a new failure.
+	if mcpProvider.Status.State == mcpv1alpha1.ProviderStateCircuitOpen {
+		if remaining := cooldownRemaining(mcpProvider); remaining > 0 {
+			return remaining
+		}
+		return r.beginHalfOpenProbe(ctx, mcpProvider, pod)
+	}
+
+	wasHalfOpen := mcpProvider.Status.State == mcpv1alpha1.ProviderStateHalfOpen
+
 	mcpProvider.Status.ConsecutiveFailures++
 	mcpProvider.Status.ReadyReplicas = 0
 	mcpProvider.Status.AvailableReplicas = 0
+	now := metav1.Now()
+	mcpProvider.Status.LastFailureAt = &now
 
 	// Get failure reason
 	reason := "Unknown"
@@ -399,11 +606,12 @@ func (r *MCPProviderReconciler) handlePodFailed(ctx context.Context, mcpProvider
 	mcpProvider.Status.SetCondition(ConditionReady, metav1.ConditionFalse, "PodFailed", reason)
 	mcpProvider.Status.SetCondition(ConditionDegraded, metav1.ConditionTrue, "PodFailed", reason)
 	r.Recorder.Event(mcpProvider, corev1.EventTypeWarning, ReasonFailed, fmt.Sprintf("Pod failed: %s", reason))
-	metrics.SetProviderState(mcpProvider.Namespace, mcpProvider.Name, "Dead")
 
-	// Check if we should restart (with backoff)
-	maxFailures := int32(5)
-	if mcpProvider.Status.ConsecutiveFailures < maxFailures {
+	maxFailures := provider.RestartPolicyMaxFailures(mcpProvider.Spec.RestartPolicy)
+	if !wasHalfOpen && mcpProvider.Status.ConsecutiveFailures < maxFailures {
+		mcpProvider.Status.State = mcpv1alpha1.ProviderStateDead
+		metrics.SetProviderState(mcpProvider.Namespace, mcpProvider.Name, "Dead")
+
 		logger.Info("Pod failed, deleting for restart",
 			"failures", mcpProvider.Status.ConsecutiveFailures,
 			"maxFailures", maxFailures)
@@ -417,8 +625,339 @@ func (r *MCPProviderReconciler) handlePodFailed(ctx context.Context, mcpProvider
 		return backoff
 	}
 
-	logger.Info("Max failures reached, not restarting", "failures", mcpProvider.Status.ConsecutiveFailures)
-	return readyRequeueAfter
+	// Either MaxFailures was just reached, or the one half-open retry
+	// failed too: (re)open the circuit instead of restarting immediately.
+	return r.openCircuit(ctx, mcpProvider, maxFailures)
+}
+
+// openCircuit transitions the provider to ProviderStateCircuitOpen,
+// computing a cooldown that doubles (per Spec.RestartPolicy.BackoffMultiplier)
+// each time the circuit reopens after a half-open retry fails, capped at
+// Spec.RestartPolicy.MaxCooldown. The failed Pod is left in place; it's
+// deleted only once the cooldown elapses and a half-open retry begins, so
+// repeated reconciles while open don't thrash the API server.
+func (r *MCPProviderReconciler) openCircuit(ctx context.Context, mcpProvider *mcpv1alpha1.MCPProvider, maxFailures int32) time.Duration {
+	logger := log.FromContext(ctx)
+
+	reopens := mcpProvider.Status.ConsecutiveFailures - maxFailures
+	if reopens < 0 {
+		reopens = 0
+	}
+	cooldown := provider.RestartCooldown(mcpProvider.Spec.RestartPolicy, reopens)
+
+	now := metav1.Now()
+	cooldownUntil := metav1.NewTime(now.Add(cooldown))
+	mcpProvider.Status.State = mcpv1alpha1.ProviderStateCircuitOpen
+	mcpProvider.Status.CooldownUntil = &cooldownUntil
+	mcpProvider.Status.SetCondition(ConditionReady, metav1.ConditionFalse, ReasonCircuitOpen,
+		fmt.Sprintf("circuit open after %d consecutive failures, retrying in %s", mcpProvider.Status.ConsecutiveFailures, cooldown))
+
+	logger.Info("Circuit breaker open", "failures", mcpProvider.Status.ConsecutiveFailures, "cooldown", cooldown)
+	r.Recorder.Event(mcpProvider, corev1.EventTypeWarning, ReasonCircuitOpen,
+		fmt.Sprintf("Circuit breaker open after %d consecutive failures, cooling down for %s", mcpProvider.Status.ConsecutiveFailures, cooldown))
+	metrics.SetProviderState(mcpProvider.Namespace, mcpProvider.Name, "CircuitOpen")
+	metrics.SetProviderCircuitState(mcpProvider.Namespace, mcpProvider.Name, "CircuitOpen")
+
+	return cooldown
+}
+
+// beginHalfOpenProbe transitions an open circuit to ProviderStateHalfOpen
+// once its cooldown has elapsed, deleting the failed Pod so the next
+// reconcile's handlePodNotFound creates exactly one replacement. Success
+// closes the circuit via handlePodRunning's ConsecutiveFailures reset;
+// another failure routes back through handlePodFailed into openCircuit
+// with a longer cooldown.
+func (r *MCPProviderReconciler) beginHalfOpenProbe(ctx context.Context, mcpProvider *mcpv1alpha1.MCPProvider, pod *corev1.Pod) time.Duration {
+	logger := log.FromContext(ctx)
+
+	mcpProvider.Status.State = mcpv1alpha1.ProviderStateHalfOpen
+	mcpProvider.Status.SetCondition(ConditionProgressing, metav1.ConditionTrue, ReasonHalfOpen, "cooldown elapsed, retrying provider once")
+
+	logger.Info("Circuit breaker cooldown elapsed, attempting half-open retry")
+	r.Recorder.Event(mcpProvider, corev1.EventTypeNormal, ReasonHalfOpen, "Circuit breaker cooldown elapsed, retrying provider")
+	metrics.SetProviderState(mcpProvider.Namespace, mcpProvider.Name, "HalfOpen")
+	metrics.SetProviderCircuitState(mcpProvider.Namespace, mcpProvider.Name, "HalfOpen")
+
+	if err := r.Delete(ctx, pod); err != nil && !errors.IsNotFound(err) {
+		logger.Error(err, "Failed to delete failed Pod for half-open retry")
+	}
+
+	return defaultRequeueAfter
+}
+
+// cooldownRemaining returns how much longer a CircuitOpen provider must
+// wait before its next half-open retry. A non-positive result means the
+// cooldown has elapsed.
+func cooldownRemaining(mcpProvider *mcpv1alpha1.MCPProvider) time.Duration {
+	if mcpProvider.Status.CooldownUntil == nil {
+		return 0
+	}
+	return time.Until(mcpProvider.Status.CooldownUntil.Time)
+}
+
+// gracefulDeletePod drains pod through HangarClient.DrainProvider, if a
+// HangarClient and Spec.PreStopDrainTimeout are both configured, so
+// in-flight MCP tool calls get a chance to finish before the Pod goes away,
+// then deletes it with Spec.ShutdownGracePeriod's grace period rather than
+// whatever grace the API server would otherwise default to. The container's
+// own Lifecycle.PreStop hook, if set, runs as part of the kubelet's own
+// termination sequence once the Delete call below goes through. The
+// Draining condition brackets the whole sequence so observers can tell a
+// pod is being gracefully replaced apart from it simply disappearing.
+func (r *MCPProviderReconciler) gracefulDeletePod(ctx context.Context, mcpProvider *mcpv1alpha1.MCPProvider, pod *corev1.Pod) error {
+	logger := log.FromContext(ctx)
+
+	mcpProvider.Status.SetCondition(ConditionDraining, metav1.ConditionTrue, ReasonDraining,
+		fmt.Sprintf("draining pod %q before graceful delete", pod.Name))
+	if err := r.Status().Update(ctx, mcpProvider); err != nil {
+		logger.Error(err, "Failed to set Draining condition", "pod", pod.Name)
+	}
+
+	if r.HangarClient != nil && mcpProvider.Spec.PreStopDrainTimeout != "" {
+		if timeout, err := time.ParseDuration(mcpProvider.Spec.PreStopDrainTimeout); err == nil && timeout > 0 {
+			if err := r.HangarClient.DrainProvider(ctx, mcpProvider.Name, mcpProvider.Namespace, timeout); err != nil {
+				logger.Error(err, "Failed to drain provider before deleting Pod", "pod", pod.Name)
+			}
+		}
+	}
+
+	grace := provider.TerminationGracePeriodSeconds(mcpProvider)
+	deleteErr := r.Delete(ctx, pod, client.GracePeriodSeconds(grace))
+
+	mcpProvider.Status.SetCondition(ConditionDraining, metav1.ConditionFalse, ReasonDrained, "")
+	if err := r.Status().Update(ctx, mcpProvider); err != nil {
+		logger.Error(err, "Failed to clear Draining condition", "pod", pod.Name)
+	}
+
+	if deleteErr != nil && !errors.IsNotFound(deleteErr) {
+		return deleteErr
+	}
+	return nil
+}
+
+// reconcileScalableWorkload creates the Deployment or StatefulSet backing a
+// provider if it doesn't exist yet, otherwise propagates its replica status
+// onto the MCPProvider. Unlike a single Pod, these workload kinds own their
+// own restart behavior, so the reconciler only creates and observes them.
+func (r *MCPProviderReconciler) reconcileScalableWorkload(ctx context.Context, mcpProvider *mcpv1alpha1.MCPProvider, desired client.Object) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	existing := desired.DeepCopyObject().(client.Object)
+	key := types.NamespacedName{Name: desired.GetName(), Namespace: desired.GetNamespace()}
+	err := r.Get(ctx, key, existing)
+
+	if errors.IsNotFound(err) {
+		if mcpProvider.IsCold() {
+			logger.Info("Provider is cold (replicas=0), not creating workload")
+			mcpProvider.Status.State = mcpv1alpha1.ProviderStateCold
+			mcpProvider.Status.ReadyReplicas = 0
+			mcpProvider.Status.AvailableReplicas = 0
+			mcpProvider.Status.SetCondition(ConditionReady, metav1.ConditionFalse, "Cold", "Provider is cold, will start on demand")
+			mcpProvider.Status.SetCondition(ConditionAvailable, metav1.ConditionFalse, "Cold", "No replicas requested")
+			if err := r.Status().Update(ctx, mcpProvider); err != nil {
+				return ctrl.Result{}, err
+			}
+			metrics.SetProviderState(mcpProvider.Namespace, mcpProvider.Name, string(mcpv1alpha1.ProviderStateCold))
+			return ctrl.Result{RequeueAfter: coldRequeueAfter}, nil
+		}
+
+		logger.Info("Creating workload for provider", "name", desired.GetName())
+		if err := r.Create(ctx, desired); err != nil {
+			logger.Error(err, "Failed to create workload")
+			mcpProvider.Status.SetCondition(ConditionReady, metav1.ConditionFalse, "WorkloadCreateFailed", err.Error())
+			mcpProvider.Status.State = mcpv1alpha1.ProviderStateDead
+			if serr := r.Status().Update(ctx, mcpProvider); serr != nil {
+				return ctrl.Result{}, serr
+			}
+			r.Recorder.Event(mcpProvider, corev1.EventTypeWarning, ReasonFailed, fmt.Sprintf("Failed to create workload: %v", err))
+			return ctrl.Result{RequeueAfter: errorRequeueAfter}, nil
+		}
+
+		mcpProvider.Status.State = mcpv1alpha1.ProviderStateInitializing
+		mcpProvider.Status.PodName = desired.GetName()
+		now := metav1.Now()
+		mcpProvider.Status.LastStartedAt = &now
+		mcpProvider.Status.SetCondition(ConditionProgressing, metav1.ConditionTrue, "WorkloadCreated", "Workload created, waiting for ready replicas")
+		if err := r.Status().Update(ctx, mcpProvider); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		r.Recorder.Event(mcpProvider, corev1.EventTypeNormal, ReasonStarting, "Creating provider workload")
+		metrics.SetProviderState(mcpProvider.Namespace, mcpProvider.Name, string(mcpv1alpha1.ProviderStateInitializing))
+		return ctrl.Result{RequeueAfter: defaultRequeueAfter}, nil
+	} else if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.updateScalableWorkloadSpec(ctx, existing, desired); err != nil {
+		logger.Error(err, "Failed to update workload")
+		return ctrl.Result{}, err
+	}
+
+	return r.syncWorkloadReplicaStatus(ctx, mcpProvider, existing)
+}
+
+// updateScalableWorkloadSpec diffs desired's replicas and pod template
+// against existing's and Updates existing in place if either changed, so
+// edits to MCPProvider.Spec (image, env, resources, replicas, ...) reach a
+// running Deployment/StatefulSet instead of only taking effect on first
+// create. existing keeps its own ObjectMeta (ResourceVersion, etc.); only
+// Spec.Replicas and Spec.Template are copied from desired.
+func (r *MCPProviderReconciler) updateScalableWorkloadSpec(ctx context.Context, existing, desired client.Object) error {
+	switch d := desired.(type) {
+	case *appsv1.Deployment:
+		e := existing.(*appsv1.Deployment)
+		if scalableWorkloadSpecChanged(e.Spec.Replicas, d.Spec.Replicas, e.Spec.Template, d.Spec.Template) {
+			e.Spec.Replicas = d.Spec.Replicas
+			e.Spec.Template = d.Spec.Template
+			return r.Update(ctx, e)
+		}
+	case *appsv1.StatefulSet:
+		e := existing.(*appsv1.StatefulSet)
+		if scalableWorkloadSpecChanged(e.Spec.Replicas, d.Spec.Replicas, e.Spec.Template, d.Spec.Template) {
+			e.Spec.Replicas = d.Spec.Replicas
+			e.Spec.Template = d.Spec.Template
+			return r.Update(ctx, e)
+		}
+	default:
+		return fmt.Errorf("unsupported workload type %T", desired)
+	}
+	return nil
+}
+
+// scalableWorkloadSpecChanged reports whether replicas or the pod template
+// differ between an existing Deployment/StatefulSet and its desired form.
+func scalableWorkloadSpecChanged(existingReplicas, desiredReplicas *int32, existingTemplate, desiredTemplate corev1.PodTemplateSpec) bool {
+	existingVal, desiredVal := int32(1), int32(1)
+	if existingReplicas != nil {
+		existingVal = *existingReplicas
+	}
+	if desiredReplicas != nil {
+		desiredVal = *desiredReplicas
+	}
+	return existingVal != desiredVal || !reflect.DeepEqual(existingTemplate, desiredTemplate)
+}
+
+// syncWorkloadReplicaStatus synchronizes MCPProvider status with the
+// ReadyReplicas/AvailableReplicas reported by a Deployment or StatefulSet.
+func (r *MCPProviderReconciler) syncWorkloadReplicaStatus(ctx context.Context, mcpProvider *mcpv1alpha1.MCPProvider, obj client.Object) (ctrl.Result, error) {
+	var replicas, ready, available int32
+	switch workload := obj.(type) {
+	case *appsv1.Deployment:
+		replicas, ready, available = workload.Status.Replicas, workload.Status.ReadyReplicas, workload.Status.AvailableReplicas
+	case *appsv1.StatefulSet:
+		replicas, ready, available = workload.Status.Replicas, workload.Status.ReadyReplicas, workload.Status.AvailableReplicas
+	default:
+		return ctrl.Result{}, fmt.Errorf("unsupported workload type %T", obj)
+	}
+
+	mcpProvider.Status.Replicas = replicas
+	mcpProvider.Status.ReadyReplicas = ready
+	mcpProvider.Status.AvailableReplicas = available
+
+	requeueAfter := defaultRequeueAfter
+	switch {
+	case ready >= mcpProvider.GetReplicas():
+		mcpProvider.Status.State = mcpv1alpha1.ProviderStateReady
+		mcpProvider.Status.ConsecutiveFailures = 0
+		now := metav1.Now()
+		mcpProvider.Status.LastHealthCheck = &now
+		mcpProvider.Status.SetCondition(ConditionReady, metav1.ConditionTrue, "ReplicasReady", fmt.Sprintf("%d/%d replicas ready", ready, mcpProvider.GetReplicas()))
+		mcpProvider.Status.SetCondition(ConditionProgressing, metav1.ConditionFalse, "Reconciled", "")
+		mcpProvider.Status.SetCondition(ConditionAvailable, metav1.ConditionTrue, "Available", "Provider is available")
+		r.Recorder.Event(mcpProvider, corev1.EventTypeNormal, ReasonReady, "Provider is ready")
+		metrics.SetProviderState(mcpProvider.Namespace, mcpProvider.Name, "Ready")
+		requeueAfter = readyRequeueAfter
+	default:
+		mcpProvider.Status.State = mcpv1alpha1.ProviderStateInitializing
+		mcpProvider.Status.SetCondition(ConditionProgressing, metav1.ConditionTrue, "ReplicasPending", fmt.Sprintf("%d/%d replicas ready", ready, mcpProvider.GetReplicas()))
+		metrics.SetProviderState(mcpProvider.Namespace, mcpProvider.Name, "Initializing")
+	}
+
+	if err := r.Status().Update(ctx, mcpProvider); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// reconcileImagePullCredentials materializes the generated dockerconfigjson
+// Secret from Spec.ImagePullCredentials.ConfigMapRef, if set, creating or
+// updating it to track the source ConfigMap. It's a no-op when the
+// provider doesn't use the inline-credentials path.
+func (r *MCPProviderReconciler) reconcileImagePullCredentials(ctx context.Context, mcpProvider *mcpv1alpha1.MCPProvider) error {
+	creds := mcpProvider.Spec.ImagePullCredentials
+	if creds == nil || creds.ConfigMapRef == nil {
+		return nil
+	}
+
+	key := creds.ConfigMapRef.Key
+	if key == "" {
+		key = provider.DefaultImagePullCredentialsConfigMapKey
+	}
+
+	sourceConfigMap := &corev1.ConfigMap{}
+	cmKey := types.NamespacedName{Name: creds.ConfigMapRef.Name, Namespace: mcpProvider.Namespace}
+	if err := r.Get(ctx, cmKey, sourceConfigMap); err != nil {
+		return fmt.Errorf("reading image pull credentials ConfigMap %q: %w", creds.ConfigMapRef.Name, err)
+	}
+
+	dockerConfigJSON, ok := sourceConfigMap.Data[key]
+	if !ok {
+		return fmt.Errorf("image pull credentials ConfigMap %q has no key %q", creds.ConfigMapRef.Name, key)
+	}
+
+	secret := provider.BuildImagePullSecret(mcpProvider, []byte(dockerConfigJSON))
+	if err := controllerutil.SetControllerReference(mcpProvider, secret, r.Scheme); err != nil {
+		return err
+	}
+
+	existing := &corev1.Secret{}
+	secretKey := types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}
+	if err := r.Get(ctx, secretKey, existing); errors.IsNotFound(err) {
+		return r.Create(ctx, secret)
+	} else if err != nil {
+		return err
+	}
+
+	existing.Data = secret.Data
+	return r.Update(ctx, existing)
+}
+
+// reconcileHeadlessService creates the headless Service a StatefulSet
+// workload needs for its Pods' stable network identity, if it doesn't
+// already exist. The Service is immutable once created, so existing
+// Services are left untouched.
+func (r *MCPProviderReconciler) reconcileHeadlessService(ctx context.Context, mcpProvider *mcpv1alpha1.MCPProvider) error {
+	if mcpProvider.Spec.ServiceName == "" {
+		return fmt.Errorf("serviceName is required for StatefulSet workloads")
+	}
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mcpProvider.Spec.ServiceName,
+			Namespace: mcpProvider.Namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector:  provider.SelectorLabels(mcpProvider),
+		},
+	}
+
+	if err := controllerutil.SetControllerReference(mcpProvider, svc, r.Scheme); err != nil {
+		return err
+	}
+
+	key := types.NamespacedName{Name: svc.Name, Namespace: svc.Namespace}
+	if err := r.Get(ctx, key, &corev1.Service{}); errors.IsNotFound(err) {
+		if err := r.Create(ctx, svc); err != nil {
+			return fmt.Errorf("creating headless service: %w", err)
+		}
+	} else if err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // reconcileRemoteProvider handles remote-mode providers
@@ -483,19 +1022,59 @@ func (r *MCPProviderReconciler) reconcileDelete(ctx context.Context, mcpProvider
 	logger := log.FromContext(ctx)
 	logger.Info("Handling deletion for MCPProvider")
 
-	// Clean up Pod if container mode
+	// Clean up the workload if container mode
 	if mcpProvider.IsContainerMode() {
-		pod := &corev1.Pod{}
-		podKey := types.NamespacedName{
+		workloadKey := types.NamespacedName{
 			Name:      mcpProvider.GetPodName(),
 			Namespace: mcpProvider.Namespace,
 		}
-		if err := r.Get(ctx, podKey, pod); err == nil {
-			logger.Info("Deleting Pod", "pod", pod.Name)
-			if err := r.Delete(ctx, pod); err != nil && !errors.IsNotFound(err) {
+
+		var workload client.Object
+		switch mcpProvider.Spec.WorkloadKind {
+		case mcpv1alpha1.WorkloadKindDeployment:
+			workload = &appsv1.Deployment{}
+		case mcpv1alpha1.WorkloadKindStatefulSet:
+			workload = &appsv1.StatefulSet{}
+		default:
+			workload = &corev1.Pod{}
+		}
+
+		if err := r.Get(ctx, workloadKey, workload); err == nil {
+			// Block finalizer removal on draining so in-flight MCP tool
+			// calls get a chance to finish, but only up to
+			// PreStopDrainTimeout - then force-delete with a zero grace
+			// period as a safety net so a stuck drain can't wedge deletion
+			// of the MCPProvider forever.
+			if r.HangarClient != nil && mcpProvider.Spec.PreStopDrainTimeout != "" {
+				if timeout, err := time.ParseDuration(mcpProvider.Spec.PreStopDrainTimeout); err == nil && timeout > 0 {
+					mcpProvider.Status.SetCondition(ConditionDraining, metav1.ConditionTrue, ReasonDraining,
+						fmt.Sprintf("draining workload %q before deletion", workload.GetName()))
+					if serr := r.Status().Update(ctx, mcpProvider); serr != nil {
+						logger.Error(serr, "Failed to set Draining condition")
+					}
+
+					if err := r.HangarClient.DrainProvider(ctx, mcpProvider.Name, mcpProvider.Namespace, timeout); err != nil {
+						logger.Error(err, "Failed to drain provider before deleting workload")
+					}
+				}
+			}
+
+			logger.Info("Deleting workload", "name", workload.GetName())
+			if err := r.Delete(ctx, workload, client.GracePeriodSeconds(0)); err != nil && !errors.IsNotFound(err) {
 				return ctrl.Result{}, err
 			}
 		}
+
+		if mcpProvider.Spec.WorkloadKind == mcpv1alpha1.WorkloadKindStatefulSet && mcpProvider.Spec.ServiceName != "" {
+			svc := &corev1.Service{}
+			svcKey := types.NamespacedName{Name: mcpProvider.Spec.ServiceName, Namespace: mcpProvider.Namespace}
+			if err := r.Get(ctx, svcKey, svc); err == nil {
+				logger.Info("Deleting headless Service", "service", svc.Name)
+				if err := r.Delete(ctx, svc); err != nil && !errors.IsNotFound(err) {
+					return ctrl.Result{}, err
+				}
+			}
+		}
 	}
 
 	// Deregister from MCP-Hangar core
@@ -528,8 +1107,19 @@ func (r *MCPProviderReconciler) reconcileDelete(ctx context.Context, mcpProvider
 
 // SetupWithManager sets up the controller with the Manager
 func (r *MCPProviderReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	healthEvents := make(chan event.GenericEvent)
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		return r.runHealthWatch(ctx, healthEvents)
+	})); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&mcpv1alpha1.MCPProvider{}).
 		Owns(&corev1.Pod{}).
+		Owns(&appsv1.Deployment{}).
+		Owns(&appsv1.StatefulSet{}).
+		Owns(&corev1.Service{}).
+		WatchesRawSource(source.Channel(healthEvents, &handler.EnqueueRequestForObject{})).
 		Complete(r)
 }
@@ -0,0 +1,428 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+	"github.com/mapyr/mcp-hangar/operator/pkg/hangar"
+	"github.com/mapyr/mcp-hangar/operator/pkg/metrics"
+	"github.com/mapyr/mcp-hangar/operator/pkg/provider"
+)
+
+// reconcilePodSet handles the WorkloadKindPod path for a provider with more
+// than one replica: unlike reconcilePod, which owns exactly one Pod, this
+// manages a set of Pods keyed by their deterministic ordinal name
+// (provider.PodOrdinalName), rolling from one Pod template hash to the next
+// within the MaxSurge/MaxUnavailable budgets Spec.RollingUpdate configures -
+// the same shape of algorithm the Deployment controller uses for a
+// ReplicaSet, scaled down to what a handful of Pods need.
+func (r *MCPProviderReconciler) reconcilePodSet(ctx context.Context, mcpProvider *mcpv1alpha1.MCPProvider, desiredPods []*corev1.Pod) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if mcpProvider.IsCold() {
+		logger.Info("Provider is cold (replicas=0), not creating Pods")
+		mcpProvider.Status.State = mcpv1alpha1.ProviderStateCold
+		mcpProvider.Status.ReadyReplicas = 0
+		mcpProvider.Status.AvailableReplicas = 0
+		mcpProvider.Status.SetCondition(ConditionReady, metav1.ConditionFalse, "Cold", "Provider is cold, will start on demand")
+		mcpProvider.Status.SetCondition(ConditionAvailable, metav1.ConditionFalse, "Cold", "No replicas requested")
+		if err := r.Status().Update(ctx, mcpProvider); err != nil {
+			return ctrl.Result{}, err
+		}
+		metrics.SetProviderState(mcpProvider.Namespace, mcpProvider.Name, string(mcpv1alpha1.ProviderStateCold))
+		return ctrl.Result{RequeueAfter: coldRequeueAfter}, nil
+	}
+
+	existingList := &corev1.PodList{}
+	if err := r.List(ctx, existingList, client.InNamespace(mcpProvider.Namespace), client.MatchingLabels(provider.SelectorLabels(mcpProvider))); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	desiredByName := make(map[string]*corev1.Pod, len(desiredPods))
+	for _, pod := range desiredPods {
+		desiredByName[pod.Name] = pod
+	}
+	currentHash := ""
+	if len(desiredPods) > 0 {
+		currentHash = desiredPods[0].Labels[provider.LabelTemplateHash]
+	}
+
+	existingByName := make(map[string]*corev1.Pod, len(existingList.Items))
+	for i := range existingList.Items {
+		existingByName[existingList.Items[i].Name] = &existingList.Items[i]
+	}
+
+	// Scale down: a Pod whose ordinal is no longer desired (Replicas
+	// shrank) is removed outright, not subject to the rollout budget.
+	for name, pod := range existingByName {
+		if _, wanted := desiredByName[name]; !wanted {
+			logger.Info("Deleting Pod no longer desired", "pod", name)
+			if err := r.gracefulDeletePod(ctx, mcpProvider, pod); err != nil {
+				return ctrl.Result{}, err
+			}
+			delete(existingByName, name)
+		}
+	}
+
+	var stale, onHash []*corev1.Pod
+	for _, pod := range existingByName {
+		if pod.Labels[provider.LabelTemplateHash] == currentHash {
+			onHash = append(onHash, pod)
+		} else {
+			stale = append(stale, pod)
+		}
+	}
+	sortPodsByName(stale)
+	sortPodsByName(onHash)
+
+	replicas := mcpProvider.GetReplicas()
+	strategyType := provider.ResolveRolloutStrategyType(mcpProvider.Spec.RolloutStrategy)
+	rollingUpdateConfig := provider.ResolveRollingUpdateConfig(mcpProvider.Spec.RolloutStrategy, mcpProvider.Spec.RollingUpdate)
+
+	// targetOnHash is how many Pods the current strategy wants on
+	// currentHash right now. RollingUpdate, Recreate, and BlueGreen all
+	// want every replica there eventually; Canary deliberately holds at a
+	// fraction of it until RolloutStrategy.Canary.Weight itself changes.
+	var maxSurge, maxUnavailable, targetOnHash int32
+	switch strategyType {
+	case mcpv1alpha1.RolloutStrategyRecreate, mcpv1alpha1.RolloutStrategyBlueGreen:
+		// Take every stale Pod down before any replacement is created:
+		// the same all-or-nothing tradeoff as Deployment's Recreate. A
+		// WorkloadKindPod provider's Pods are named by ordinal and reused
+		// across template hashes, so there's no spare ordinal for
+		// BlueGreen to stand a second full copy of the set up on; what
+		// distinguishes it from Recreate is the PromoteAfter bake window
+		// syncPodSetStatus holds RolloutPhase at Promoting for afterward.
+		maxSurge, maxUnavailable, targetOnHash = 0, replicas, replicas
+	case mcpv1alpha1.RolloutStrategyCanary:
+		maxSurge = provider.ResolveMaxSurge(rollingUpdateConfig, replicas)
+		maxUnavailable = provider.ResolveMaxUnavailable(rollingUpdateConfig, replicas)
+		targetOnHash = provider.CanaryTargetReplicas(replicas, provider.ResolveCanaryWeight(canarySpec(mcpProvider.Spec.RolloutStrategy)))
+	default:
+		maxSurge = provider.ResolveMaxSurge(rollingUpdateConfig, replicas)
+		maxUnavailable = provider.ResolveMaxUnavailable(rollingUpdateConfig, replicas)
+		targetOnHash = replicas
+	}
+
+	ready := int32(0)
+	for _, pod := range existingByName {
+		if podAllContainersReady(pod) {
+			ready++
+		}
+	}
+
+	// Roll out: replace stale-template Pods, but never let more than
+	// maxUnavailable of them be down (missing or not ready) at once, and
+	// never replace more of them than targetOnHash calls for - the rest
+	// keep serving traffic on the old template untouched. This doesn't
+	// bound simultaneous scale-up (new ordinals created below), which is
+	// a separate concern from rolling one template hash into another.
+	unavailable := replicas - ready
+	onHashCount := int32(len(onHash))
+	for _, pod := range stale {
+		if onHashCount >= targetOnHash {
+			break
+		}
+		if unavailable >= maxUnavailable {
+			break
+		}
+		logger.Info("Deleting stale Pod for rolling update", "pod", pod.Name)
+		if err := r.gracefulDeletePod(ctx, mcpProvider, pod); err != nil {
+			return ctrl.Result{}, err
+		}
+		delete(existingByName, pod.Name)
+		unavailable++
+		onHashCount++
+	}
+
+	// PersistentState: make sure every ordinal's PVCs exist before its Pod
+	// is created. PVCs are never deleted here, so a scale-down (including
+	// to zero on IdleTTL) leaves them in place to be reattached to the
+	// same ordinal later.
+	if mcpProvider.Spec.PersistentState != nil {
+		for _, pvc := range provider.BuildPersistentStatePVCs(mcpProvider) {
+			if err := r.Create(ctx, pvc); err != nil && !errors.IsAlreadyExists(err) {
+				logger.Error(err, "Failed to create PersistentState PVC", "pvc", pvc.Name)
+				return ctrl.Result{}, err
+			}
+		}
+	}
+	orderedReady := provider.ResolvePodManagementPolicy(mcpProvider.Spec.PersistentState) == mcpv1alpha1.PodManagementPolicyOrderedReady
+
+	// Scale up / create replacements: never let more than maxSurge Pods
+	// exist above Replicas at once.
+	var missing []*corev1.Pod
+	for name, desired := range desiredByName {
+		if _, ok := existingByName[name]; !ok {
+			missing = append(missing, desired)
+		}
+	}
+	sortPodsByName(missing)
+
+	surgeBudget := replicas + maxSurge - int32(len(existingByName))
+	created := 0
+	for _, pod := range missing {
+		if int32(created) >= surgeBudget {
+			break
+		}
+		if orderedReady {
+			if ordinal, ok := podOrdinal(pod); ok && ordinal > 0 {
+				prev, exists := existingByName[provider.PodOrdinalName(mcpProvider, ordinal-1)]
+				if !exists || !podAllContainersReady(prev) {
+					continue
+				}
+			}
+		}
+		logger.Info("Creating Pod for provider", "pod", pod.Name)
+		if err := r.Create(ctx, pod); err != nil && !errors.IsAlreadyExists(err) {
+			logger.Error(err, "Failed to create Pod", "pod", pod.Name)
+			return ctrl.Result{}, err
+		}
+		created++
+	}
+
+	return r.syncPodSetStatus(ctx, mcpProvider, currentHash)
+}
+
+// syncPodSetStatus re-lists the provider's Pods, aggregates
+// ReadyReplicas/AvailableReplicas across all of them (old-template Pods
+// still serve traffic during a rollout, so they count too), tracks
+// UpdatedReplicas/RolloutPhase for the subset on the current template
+// hash, and registers their endpoints with Hangar so tool calls can
+// load-balance across replicas. During a Canary rollout, old and new
+// endpoints are registered together with EndpointWeights so Hangar core
+// splits new sessions between them by Canary.Weight.
+func (r *MCPProviderReconciler) syncPodSetStatus(ctx context.Context, mcpProvider *mcpv1alpha1.MCPProvider, currentHash string) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	podList := &corev1.PodList{}
+	if err := r.List(ctx, podList, client.InNamespace(mcpProvider.Namespace), client.MatchingLabels(provider.SelectorLabels(mcpProvider))); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	replicas := mcpProvider.GetReplicas()
+	strategyType := provider.ResolveRolloutStrategyType(mcpProvider.Spec.RolloutStrategy)
+
+	var ready, available, updated int32
+	var endpoints, oldEndpoints []string
+	var onHashPods []*corev1.Pod
+	requeueAfter := defaultRequeueAfter
+
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		onCurrentHash := pod.Labels[provider.LabelTemplateHash] == currentHash
+		if onCurrentHash {
+			updated++
+			onHashPods = append(onHashPods, pod)
+		}
+		if !podAllContainersReady(pod) {
+			continue
+		}
+		ready++
+		if remaining := minReadySecondsRemaining(pod, mcpProvider.Spec.MinReadySeconds); remaining <= 0 {
+			available++
+			if pod.Status.PodIP == "" {
+				continue
+			}
+			if onCurrentHash {
+				endpoints = append(endpoints, pod.Status.PodIP)
+			} else {
+				oldEndpoints = append(oldEndpoints, pod.Status.PodIP)
+			}
+		} else if remaining < requeueAfter {
+			requeueAfter = remaining
+		}
+	}
+
+	mcpProvider.Status.Replicas = replicas
+	mcpProvider.Status.ReadyReplicas = ready
+	mcpProvider.Status.AvailableReplicas = available
+	mcpProvider.Status.UpdatedReplicas = updated
+	mcpProvider.Status.RolloutPhase = rolloutPhase(mcpProvider, strategyType, updated, onHashPods)
+
+	if mcpProvider.Spec.PersistentState != nil {
+		mcpProvider.Status.UpdateRevision = currentHash
+		if updated == replicas {
+			mcpProvider.Status.CurrentRevision = currentHash
+		}
+	}
+
+	if strategyType == mcpv1alpha1.RolloutStrategyCanary {
+		if mcpProvider.Status.RolloutPhase == mcpv1alpha1.RolloutPhasePaused {
+			mcpProvider.Status.SetCondition(ConditionCanaryAnalysis, metav1.ConditionTrue, "Analyzing", fmt.Sprintf("Holding at %d/%d replicas on the new template for analysis", updated, replicas))
+		} else {
+			mcpProvider.Status.SetCondition(ConditionCanaryAnalysis, metav1.ConditionFalse, "NotAnalyzing", "")
+		}
+	}
+
+	allEndpoints := append(append([]string{}, endpoints...), oldEndpoints...)
+
+	if available >= replicas {
+		mcpProvider.Status.State = mcpv1alpha1.ProviderStateReady
+		mcpProvider.Status.ConsecutiveFailures = 0
+		now := metav1.Now()
+		mcpProvider.Status.LastHealthCheck = &now
+		mcpProvider.Status.SetCondition(ConditionReady, metav1.ConditionTrue, "ReplicasReady", fmt.Sprintf("%d/%d replicas ready", ready, replicas))
+		mcpProvider.Status.SetCondition(ConditionProgressing, metav1.ConditionFalse, "Reconciled", "")
+		mcpProvider.Status.SetCondition(ConditionAvailable, metav1.ConditionTrue, "Available", fmt.Sprintf("%d/%d replicas available", available, replicas))
+		r.Recorder.Event(mcpProvider, corev1.EventTypeNormal, ReasonReady, "Provider is ready")
+		metrics.SetProviderState(mcpProvider.Namespace, mcpProvider.Name, "Ready")
+		requeueAfter = readyRequeueAfter
+
+		if r.HangarClient != nil && len(allEndpoints) > 0 {
+			req := &hangar.RegisterProviderRequest{
+				Name:      mcpProvider.Name,
+				Namespace: mcpProvider.Namespace,
+				Mode:      string(mcpv1alpha1.ProviderModeContainer),
+				Endpoint:  allEndpoints[0],
+				Endpoints: allEndpoints,
+			}
+			if strategyType == mcpv1alpha1.RolloutStrategyCanary && len(endpoints) > 0 && len(oldEndpoints) > 0 {
+				weight := provider.ResolveCanaryWeight(canarySpec(mcpProvider.Spec.RolloutStrategy))
+				req.EndpointWeights = make(map[string]int32, len(allEndpoints))
+				for _, ep := range endpoints {
+					req.EndpointWeights[ep] = weight
+				}
+				for _, ep := range oldEndpoints {
+					req.EndpointWeights[ep] = 100 - weight
+				}
+			}
+			if err := r.HangarClient.RegisterProvider(ctx, req); err != nil {
+				logger.Error(err, "Failed to register provider endpoints with Hangar")
+			}
+		}
+	} else {
+		mcpProvider.Status.State = mcpv1alpha1.ProviderStateInitializing
+		mcpProvider.Status.SetCondition(ConditionProgressing, metav1.ConditionTrue, "ReplicasPending", fmt.Sprintf("%d/%d replicas ready", ready, replicas))
+		mcpProvider.Status.SetCondition(ConditionAvailable, metav1.ConditionFalse, "ReplicasPending", fmt.Sprintf("%d/%d replicas available", available, replicas))
+		metrics.SetProviderState(mcpProvider.Namespace, mcpProvider.Name, "Initializing")
+	}
+
+	if err := r.Status().Update(ctx, mcpProvider); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// podAllContainersReady reports whether every container status Pod reports
+// is Ready, the same bar handlePodRunning applies to the single-Pod path.
+func podAllContainersReady(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+	if len(pod.Status.ContainerStatuses) == 0 {
+		return false
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		if !cs.Ready {
+			return false
+		}
+	}
+	return true
+}
+
+// sortPodsByName orders pods by name for deterministic rollout ordering.
+func sortPodsByName(pods []*corev1.Pod) {
+	sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+}
+
+// podOrdinal reads back the ordinal provider.BuildPodsForProvider recorded
+// on pod via provider.LabelPodOrdinal.
+func podOrdinal(pod *corev1.Pod) (int32, bool) {
+	raw, ok := pod.Labels[provider.LabelPodOrdinal]
+	if !ok {
+		return 0, false
+	}
+	ordinal, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, false
+	}
+	return int32(ordinal), true
+}
+
+// rolloutPhase derives RolloutPhase from how many Pods are on currentHash
+// against the current strategy's target, and, once that target is met, how
+// long those Pods have stayed continuously ready.
+func rolloutPhase(mcpProvider *mcpv1alpha1.MCPProvider, strategyType mcpv1alpha1.RolloutStrategyType, updated int32, onHashPods []*corev1.Pod) mcpv1alpha1.RolloutPhase {
+	replicas := mcpProvider.GetReplicas()
+	target := replicas
+	if strategyType == mcpv1alpha1.RolloutStrategyCanary {
+		target = provider.CanaryTargetReplicas(replicas, provider.ResolveCanaryWeight(canarySpec(mcpProvider.Spec.RolloutStrategy)))
+	}
+	if updated < target {
+		return mcpv1alpha1.RolloutPhaseProgressing
+	}
+
+	since, allReady := allPodsReadySince(onHashPods)
+	if !allReady {
+		return mcpv1alpha1.RolloutPhaseProgressing
+	}
+
+	switch strategyType {
+	case mcpv1alpha1.RolloutStrategyCanary:
+		if target >= replicas {
+			return mcpv1alpha1.RolloutPhaseComplete
+		}
+		if time.Since(since) < provider.ResolveAnalysisInterval(canarySpec(mcpProvider.Spec.RolloutStrategy)) {
+			return mcpv1alpha1.RolloutPhasePaused
+		}
+		return mcpv1alpha1.RolloutPhaseProgressing
+	case mcpv1alpha1.RolloutStrategyBlueGreen:
+		if time.Since(since) < provider.ResolvePromoteAfter(blueGreenSpec(mcpProvider.Spec.RolloutStrategy)) {
+			return mcpv1alpha1.RolloutPhasePromoting
+		}
+		return mcpv1alpha1.RolloutPhaseComplete
+	default:
+		return mcpv1alpha1.RolloutPhaseComplete
+	}
+}
+
+// allPodsReadySince returns the latest time any of pods' Ready condition
+// last transitioned to true, so a caller can tell how long the whole set
+// has stayed continuously ready - the basis for both the BlueGreen
+// PromoteAfter gate and the Canary AnalysisInterval one. ok is false if
+// pods is empty or any of them isn't currently ready.
+func allPodsReadySince(pods []*corev1.Pod) (since time.Time, ok bool) {
+	if len(pods) == 0 {
+		return time.Time{}, false
+	}
+	for _, pod := range pods {
+		if !podAllContainersReady(pod) {
+			return time.Time{}, false
+		}
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionTrue && cond.LastTransitionTime.Time.After(since) {
+				since = cond.LastTransitionTime.Time
+			}
+		}
+	}
+	return since, true
+}
+
+// canarySpec and blueGreenSpec read strategy's per-type parameters without
+// every caller needing its own nil check for RolloutStrategy itself.
+func canarySpec(strategy *mcpv1alpha1.RolloutStrategy) *mcpv1alpha1.CanaryStrategy {
+	if strategy == nil {
+		return nil
+	}
+	return strategy.Canary
+}
+
+func blueGreenSpec(strategy *mcpv1alpha1.RolloutStrategy) *mcpv1alpha1.BlueGreenStrategy {
+	if strategy == nil {
+		return nil
+	}
+	return strategy.BlueGreen
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+// healthEventDebounce coalesces a burst of HangarClient health events for
+// the same provider (e.g. several tool-list updates in quick succession)
+// into a single enqueue.
+const healthEventDebounce = 500 * time.Millisecond
+
+// runHealthWatch subscribes to HangarClient.WatchHealth for as long as mgr
+// runs and, debounced per provider, pushes a GenericEvent onto events so
+// SetupWithManager's source.Channel can enqueue that MCPProvider right
+// away instead of waiting for readyRequeueAfter. If WatchHealth fails to
+// start, or its channel closes because the watch stream died permanently,
+// runHealthWatch returns without error: periodic reconciliation remains the
+// safety net either way.
+func (r *MCPProviderReconciler) runHealthWatch(ctx context.Context, events chan<- event.GenericEvent) error {
+	if r.HangarClient == nil {
+		return nil
+	}
+	logger := log.FromContext(ctx)
+
+	health, err := r.HangarClient.WatchHealth(ctx, "")
+	if err != nil {
+		logger.Error(err, "Failed to start Hangar health watch, relying on periodic reconcile only")
+		return nil
+	}
+
+	pending := make(map[string]*time.Timer)
+	defer func() {
+		for _, t := range pending {
+			t.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case healthEvent, ok := <-health:
+			if !ok {
+				logger.Info("Hangar health watch closed permanently, relying on periodic reconcile only")
+				return nil
+			}
+
+			key := healthEvent.Namespace + "/" + healthEvent.Name
+			if t, exists := pending[key]; exists {
+				t.Stop()
+			}
+			pending[key] = time.AfterFunc(healthEventDebounce, func() {
+				obj := &mcpv1alpha1.MCPProvider{
+					ObjectMeta: metav1.ObjectMeta{Name: healthEvent.Name, Namespace: healthEvent.Namespace},
+				}
+				select {
+				case events <- event.GenericEvent{Object: obj}:
+				case <-ctx.Done():
+				}
+			})
+		}
+	}
+}
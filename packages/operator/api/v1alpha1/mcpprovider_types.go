@@ -4,6 +4,7 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // ProviderMode defines the execution mode for a provider
@@ -17,8 +18,347 @@ const (
 	ProviderModeRemote ProviderMode = "remote"
 )
 
+// SecurityProfile names a Kubernetes Pod Security Standards profile that
+// BuildWorkloadForProvider gates the generated pod template against.
+// +kubebuilder:validation:Enum=privileged;baseline;restricted
+type SecurityProfile string
+
+const (
+	// SecurityProfilePrivileged applies no Pod Security Standards gating.
+	SecurityProfilePrivileged SecurityProfile = "privileged"
+	// SecurityProfileBaseline blocks known privilege escalations while
+	// staying compatible with most common container images.
+	SecurityProfileBaseline SecurityProfile = "baseline"
+	// SecurityProfileRestricted enforces current Pod hardening best
+	// practice. Recommended for new providers.
+	SecurityProfileRestricted SecurityProfile = "restricted"
+)
+
+// WorkloadKind selects the Kubernetes workload BuildWorkloadForProvider
+// produces for a container-mode provider.
+// +kubebuilder:validation:Enum=Pod;Deployment;StatefulSet
+type WorkloadKind string
+
+const (
+	// WorkloadKindPod runs the provider as a single Pod the reconciler
+	// recreates directly on failure. The default, suited to short-lived
+	// tool executions.
+	WorkloadKindPod WorkloadKind = "Pod"
+	// WorkloadKindDeployment runs the provider as a Deployment, suited
+	// to stateless long-running HTTP/SSE MCP servers that scale
+	// horizontally and survive node reboots.
+	WorkloadKindDeployment WorkloadKind = "Deployment"
+	// WorkloadKindStatefulSet runs the provider as a StatefulSet, suited
+	// to providers that need stable network identity or per-replica
+	// storage via VolumeClaimTemplates.
+	WorkloadKindStatefulSet WorkloadKind = "StatefulSet"
+)
+
+// UpdateStrategyType names the strategy used to roll out changes to a
+// Deployment or StatefulSet workload.
+// +kubebuilder:validation:Enum=RollingUpdate;Recreate
+type UpdateStrategyType string
+
+const (
+	// UpdateStrategyRollingUpdate replaces pods incrementally. The
+	// default for both Deployment and StatefulSet workloads.
+	UpdateStrategyRollingUpdate UpdateStrategyType = "RollingUpdate"
+	// UpdateStrategyRecreate tears down all existing pods before
+	// creating replacements.
+	UpdateStrategyRecreate UpdateStrategyType = "Recreate"
+)
+
+// UpdateStrategy configures how a Deployment or StatefulSet workload rolls
+// out spec changes.
+type UpdateStrategy struct {
+	// Type of update strategy
+	// +kubebuilder:default=RollingUpdate
+	// +optional
+	Type UpdateStrategyType `json:"type,omitempty"`
+}
+
+// RolloutStrategyType names how reconcilePodSet transitions a
+// WorkloadKindPod provider to a new Pod template hash.
+// +kubebuilder:validation:Enum=Recreate;RollingUpdate;BlueGreen;Canary
+type RolloutStrategyType string
+
+const (
+	// RolloutStrategyRecreate deletes every stale-template Pod before
+	// creating any replacement, the same tradeoff as Deployment's
+	// Recreate: a full outage of the provider during the rollout, in
+	// exchange for never running old and new template Pods side by side.
+	RolloutStrategyRecreate RolloutStrategyType = "Recreate"
+	// RolloutStrategyRollingUpdate replaces Pods incrementally within the
+	// RollingUpdate field's MaxSurge/MaxUnavailable budget. The default.
+	RolloutStrategyRollingUpdate RolloutStrategyType = "RollingUpdate"
+	// RolloutStrategyBlueGreen replaces every stale-template Pod like
+	// Recreate - a WorkloadKindPod provider's Pods are named by ordinal
+	// and reused across template hashes, so there is no spare ordinal to
+	// run a second full copy of the Pod set on - but holds RolloutPhase
+	// at Promoting, deferring Complete, until the new set has stayed
+	// ready for BlueGreen.PromoteAfter. That bake window is the point:
+	// a human or automation watching RolloutPhase has PromoteAfter to
+	// notice a bad rollout before it's considered final.
+	RolloutStrategyBlueGreen RolloutStrategyType = "BlueGreen"
+	// RolloutStrategyCanary brings up new-template Pods up to Canary.Weight
+	// percent of Replicas and holds there, with RolloutPhase at Paused
+	// until they have stayed ready for Canary.AnalysisInterval. It only
+	// advances when Weight itself changes, and a changed Weight is applied
+	// on the next reconcile whether or not AnalysisInterval has elapsed.
+	RolloutStrategyCanary RolloutStrategyType = "Canary"
+)
+
+// RolloutPhase summarizes where reconcilePodSet is in a multi-Pod rollout.
+type RolloutPhase string
+
+const (
+	// RolloutPhaseProgressing means stale-template Pods are still being
+	// replaced, regardless of strategy.
+	RolloutPhaseProgressing RolloutPhase = "Progressing"
+	// RolloutPhasePaused means a Canary rollout is holding at its
+	// configured Weight until AnalysisInterval elapses.
+	RolloutPhasePaused RolloutPhase = "Paused"
+	// RolloutPhasePromoting means a BlueGreen rollout's new-template Pods
+	// are all up and ready but haven't yet stayed that way for
+	// BlueGreen.PromoteAfter, so the rollout isn't considered final yet.
+	RolloutPhasePromoting RolloutPhase = "Promoting"
+	// RolloutPhaseComplete means every Pod is on the current template hash.
+	RolloutPhaseComplete RolloutPhase = "Complete"
+)
+
+// RolloutStrategy configures how a WorkloadKindPod provider rolls out a new
+// Pod template hash. Because MCP providers hold session state and
+// long-lived streaming connections, every strategy here drains a Pod
+// through HangarClient.DrainProvider before deleting it rather than
+// replicating plain Deployment semantics, which only waits on container
+// readiness.
+type RolloutStrategy struct {
+	// Type selects the rollout strategy. Defaults to RollingUpdate.
+	// +kubebuilder:default=RollingUpdate
+	// +optional
+	Type RolloutStrategyType `json:"type,omitempty"`
+
+	// RollingUpdate parameters, used when Type is RollingUpdate. Unset
+	// falls back to the sibling Spec.RollingUpdate field.
+	// +optional
+	RollingUpdate *RollingUpdateConfig `json:"rollingUpdate,omitempty"`
+
+	// Canary parameters, used when Type is Canary.
+	// +optional
+	Canary *CanaryStrategy `json:"canary,omitempty"`
+
+	// BlueGreen parameters, used when Type is BlueGreen.
+	// +optional
+	BlueGreen *BlueGreenStrategy `json:"blueGreen,omitempty"`
+}
+
+// CanaryStrategy caps a canary rollout at Weight percent of Replicas on the
+// new Pod template hash, splitting gateway traffic between old and new by
+// the same proportion.
+type CanaryStrategy struct {
+	// Weight is the percentage (0-100) of Replicas the reconciler brings
+	// up on the new Pod template hash, and of new-session gateway traffic
+	// routed to them.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=10
+	// +optional
+	Weight int32 `json:"weight,omitempty"`
+
+	// AnalysisInterval is how long the new Pods must stay ready at Weight
+	// before RolloutPhase leaves Paused. It does not delay a spec change
+	// to Weight, which is applied on the next reconcile.
+	// +kubebuilder:default="5m"
+	// +optional
+	AnalysisInterval string `json:"analysisInterval,omitempty"`
+}
+
+// BlueGreenStrategy holds RolloutPhase at Promoting, rather than declaring
+// the rollout Complete right away, until the new Pod template hash's Pods
+// have stayed ready for PromoteAfter.
+type BlueGreenStrategy struct {
+	// PromoteAfter is how long the new Pod template hash's Pods must stay
+	// ready before the reconciler considers the rollout promoted.
+	// +kubebuilder:default="5m"
+	// +optional
+	PromoteAfter string `json:"promoteAfter,omitempty"`
+}
+
+// MetricSourceType names what an autoscaling MetricSpec measures.
+// +kubebuilder:validation:Enum=Resource;MCPToolConcurrency;MCPQueueDepth;MCPTokensPerSecond;External
+type MetricSourceType string
+
+const (
+	// MetricSourceResource reads a standard Kubernetes resource metric
+	// (cpu, memory) the same way core HPA does. Accepted for parity with
+	// core HPA's MetricSpec shape, but not yet observed by this
+	// reconciler - it has no resource-metrics-API client of its own.
+	MetricSourceResource MetricSourceType = "Resource"
+	// MetricSourceMCPToolConcurrency reads the number of tool
+	// invocations currently in flight per replica from Hangar core.
+	MetricSourceMCPToolConcurrency MetricSourceType = "MCPToolConcurrency"
+	// MetricSourceMCPQueueDepth reads the number of JSON-RPC requests
+	// queued waiting for a free replica from Hangar core.
+	MetricSourceMCPQueueDepth MetricSourceType = "MCPQueueDepth"
+	// MetricSourceMCPTokensPerSecond reads the streaming token
+	// throughput per replica from Hangar core.
+	MetricSourceMCPTokensPerSecond MetricSourceType = "MCPTokensPerSecond"
+	// MetricSourceExternal reads an arbitrary metric by Name from an
+	// external metrics adapter. Accepted for parity with core HPA's
+	// MetricSpec shape, but not yet observed by this reconciler.
+	MetricSourceExternal MetricSourceType = "External"
+)
+
+// MetricTargetType names how MetricTarget.Value should be compared against
+// an observed metric value, matching core HPA's MetricTarget semantics.
+// +kubebuilder:validation:Enum=Utilization;AverageValue;Value
+type MetricTargetType string
+
+const (
+	// MetricTargetUtilization compares a percentage, used with
+	// MetricSourceResource.
+	MetricTargetUtilization MetricTargetType = "Utilization"
+	// MetricTargetAverageValue compares a per-replica average, the usual
+	// choice for the MCP-native metric sources.
+	MetricTargetAverageValue MetricTargetType = "AverageValue"
+	// MetricTargetValue compares a single absolute value rather than a
+	// per-replica average.
+	MetricTargetValue MetricTargetType = "Value"
+)
+
+// MetricTarget is the target value a MetricSpec scales Replicas toward,
+// read as a string (like resource.Quantity) so it can hold either a plain
+// number or a Kubernetes quantity depending on Type.
+type MetricTarget struct {
+	// Type of target value.
+	// +kubebuilder:validation:Required
+	Type MetricTargetType `json:"type"`
+
+	// Value is the target, parsed according to Type.
+	// +kubebuilder:validation:Required
+	Value string `json:"value"`
+}
+
+// MetricSpec is a single autoscaling signal, modeled on
+// autoscaling/v2.MetricSpec so the MCP-native metric sources slot in
+// beside Resource and External the way core HPA users already expect.
+type MetricSpec struct {
+	// Type selects what this metric measures.
+	// +kubebuilder:validation:Required
+	Type MetricSourceType `json:"type"`
+
+	// Name identifies the metric when Type is External; ignored
+	// otherwise, since the other Types each name exactly one signal.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Target this metric scales Replicas toward.
+	// +kubebuilder:validation:Required
+	Target MetricTarget `json:"target"`
+}
+
+// AutoscalingConfig configures the reconciler's own HPA-style loop over
+// MetricSpec. MinReplicas=0 keeps IdleTTL's scale-to-zero behavior active
+// alongside autoscaling; any MinReplicas above 0 disables it, since the
+// autoscaling floor already guarantees at least that many replicas stay
+// up.
+type AutoscalingConfig struct {
+	// MinReplicas is the floor Replicas is never scaled below. Zero (the
+	// default) leaves IdleTTL free to scale the provider to zero when
+	// idle; above zero, IdleTTL's scale-to-zero is disabled because this
+	// floor already keeps replicas running.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the ceiling Replicas is never scaled above.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// Metrics the reconciler evaluates each reconcile, scaling to the
+	// largest replica count any one of them recommends - the same
+	// multiple-metrics tie-break core HPA uses.
+	// +kubebuilder:validation:MinItems=1
+	Metrics []MetricSpec `json:"metrics"`
+
+	// ScaleUpStabilization is the minimum time since the last scaling
+	// change before another scale-up is applied.
+	// +kubebuilder:default="0s"
+	// +optional
+	ScaleUpStabilization string `json:"scaleUpStabilization,omitempty"`
+
+	// ScaleDownStabilization is the minimum time since the last scaling
+	// change before another scale-down is applied.
+	// +kubebuilder:default="5m"
+	// +optional
+	ScaleDownStabilization string `json:"scaleDownStabilization,omitempty"`
+}
+
+// PersistentVolumeClaimTemplate is the subset of a PersistentVolumeClaim
+// needed for StatefulSet.Spec.VolumeClaimTemplates.
+type PersistentVolumeClaimTemplate struct {
+	// Name of the volume, matching a VolumeMount in the provider spec
+	Name string `json:"name"`
+
+	// StorageClassName of the claim
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// AccessModes for the claim
+	AccessModes []string `json:"accessModes"`
+
+	// Storage capacity requested, e.g. "10Gi"
+	Storage string `json:"storage"`
+}
+
+// PodManagementPolicyType controls the order PersistentState replicas are
+// created and rolled in, mirroring StatefulSet's own PodManagementPolicy.
+// +kubebuilder:validation:Enum=OrderedReady;Parallel
+type PodManagementPolicyType string
+
+const (
+	// PodManagementPolicyOrderedReady creates ordinal N+1 only once ordinal
+	// N is ready, the same sequencing StatefulSet defaults to.
+	PodManagementPolicyOrderedReady PodManagementPolicyType = "OrderedReady"
+	// PodManagementPolicyParallel creates every missing ordinal at once,
+	// without waiting on a lower ordinal's readiness.
+	PodManagementPolicyParallel PodManagementPolicyType = "Parallel"
+)
+
+// PersistentStateConfig gives a WorkloadKindPod provider StatefulSet-style
+// stable identity and per-replica storage without switching it over to a
+// real StatefulSet, so it keeps the circuit breaker, rollout strategies,
+// and autoscaling reconcilePodSet already applies to WorkloadKindPod -
+// none of which a native StatefulSet gets, since it's reconciled by its
+// own controller instead of ours.
+type PersistentStateConfig struct {
+	// VolumeClaimTemplates provisions one PVC per replica per template,
+	// named "<claim>-<pod ordinal name>" the way StatefulSet names its
+	// own per-replica PVCs. A PVC is created the first time its ordinal
+	// is needed and is never deleted by the reconciler - including when
+	// Replicas scales to zero on IdleTTL - so it's simply reattached to
+	// the same ordinal the next time that Pod is created.
+	// +kubebuilder:validation:MinItems=1
+	VolumeClaimTemplates []PersistentVolumeClaimTemplate `json:"volumeClaimTemplates"`
+
+	// StableNetworkID gives each replica a stable hostname
+	// ("<name>-<ordinal>") and Subdomain set to Spec.ServiceName, so a
+	// client that looks a replica up by hostname keeps reaching the same
+	// ordinal across restarts the way a StatefulSet Pod's DNS identity
+	// does. Requires ServiceName to be set.
+	// +optional
+	StableNetworkID bool `json:"stableNetworkID,omitempty"`
+
+	// PodManagementPolicy controls replica creation order. Defaults to
+	// OrderedReady.
+	// +kubebuilder:default=OrderedReady
+	// +optional
+	PodManagementPolicy PodManagementPolicyType `json:"podManagementPolicy,omitempty"`
+}
+
 // ProviderState represents the current state of a provider
-// +kubebuilder:validation:Enum=Cold;Initializing;Ready;Degraded;Dead
+// +kubebuilder:validation:Enum=Cold;Initializing;Ready;Degraded;Dead;CircuitOpen;HalfOpen
 type ProviderState string
 
 const (
@@ -27,6 +367,14 @@ const (
 	ProviderStateReady        ProviderState = "Ready"
 	ProviderStateDegraded     ProviderState = "Degraded"
 	ProviderStateDead         ProviderState = "Dead"
+	// ProviderStateCircuitOpen means the Pod has failed RestartPolicy.MaxFailures
+	// times in a row and the reconciler has stopped restarting it immediately,
+	// waiting out Status.CooldownUntil instead.
+	ProviderStateCircuitOpen ProviderState = "CircuitOpen"
+	// ProviderStateHalfOpen means the cooldown has elapsed and the
+	// reconciler is giving the provider exactly one more Pod to prove it
+	// recovered before deciding whether to close or reopen the circuit.
+	ProviderStateHalfOpen ProviderState = "HalfOpen"
 )
 
 // MCPProviderSpec defines the desired state of MCPProvider
@@ -55,13 +403,97 @@ type MCPProviderSpec struct {
 	// +optional
 	Endpoint string `json:"endpoint,omitempty"`
 
-	// Replicas is the desired number of provider replicas
+	// Replicas is the desired number of provider replicas. Ignored once
+	// Autoscaling is set - the reconciler then owns Replicas itself,
+	// patching it to whatever Autoscaling.Metrics recommend.
 	// +kubebuilder:default=1
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=10
 	// +optional
 	Replicas *int32 `json:"replicas,omitempty"`
 
+	// Autoscaling drives Replicas from MCP-native signals - active tool
+	// calls, queued JSON-RPC requests, streaming responses in progress -
+	// that a normal Kubernetes HPA can't see, since they only exist in
+	// the gateway/observability pipeline in front of these Pods, not in
+	// kube-state-metrics or the resource metrics API. Unset leaves
+	// Replicas exactly as the user set it.
+	// +optional
+	Autoscaling *AutoscalingConfig `json:"autoscaling,omitempty"`
+
+	// WorkloadKind selects the workload type the reconciler manages for
+	// this provider. Defaults to Pod.
+	// +kubebuilder:default=Pod
+	// +optional
+	WorkloadKind WorkloadKind `json:"workloadKind,omitempty"`
+
+	// UpdateStrategy configures the rollout strategy for Deployment and
+	// StatefulSet workloads. Ignored for Pod workloads.
+	// +optional
+	UpdateStrategy *UpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// VolumeClaimTemplates provisions per-replica storage for
+	// StatefulSet workloads. Ignored for Pod and Deployment workloads.
+	// +optional
+	VolumeClaimTemplates []PersistentVolumeClaimTemplate `json:"volumeClaimTemplates,omitempty"`
+
+	// ServiceName is the headless Service the reconciler creates for a
+	// StatefulSet workload's stable network identity. Required when
+	// WorkloadKind is StatefulSet.
+	// +optional
+	ServiceName string `json:"serviceName,omitempty"`
+
+	// MinReadySeconds is how long the provider Pod's Ready condition must
+	// hold continuously before the reconciler trusts it enough to flip
+	// ConditionAvailable to True, mirroring how Deployment distinguishes
+	// "Ready" (probes pass right now) from "Available" (has stayed ready
+	// long enough to trust). Zero means available as soon as ready.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
+
+	// RestartPolicy tunes the circuit breaker the reconciler applies to a
+	// Pod that keeps failing, instead of restarting it at a fixed backoff
+	// forever. Unset fields fall back to provider.RestartPolicyMaxFailures
+	// and provider.RestartCooldown's defaults.
+	// +optional
+	RestartPolicy *RestartPolicy `json:"restartPolicy,omitempty"`
+
+	// RollingUpdate bounds how many Pods the reconciler may surge above or
+	// take unavailable below Replicas while rolling a WorkloadKindPod
+	// provider from one pod template hash to the next. Ignored for
+	// Deployment and StatefulSet workloads, which roll out via their own
+	// UpdateStrategy instead. Superseded by RolloutStrategy.RollingUpdate
+	// when RolloutStrategy is set; kept for providers that only need the
+	// surge/unavailable knobs and don't care about the other strategies.
+	// +optional
+	RollingUpdate *RollingUpdateConfig `json:"rollingUpdate,omitempty"`
+
+	// RolloutStrategy selects how reconcilePodSet transitions a
+	// WorkloadKindPod provider with more than one replica to a new Pod
+	// template hash. Ignored for Deployment and StatefulSet workloads,
+	// which roll out via their own UpdateStrategy instead. Unset behaves
+	// like Type RollingUpdate with RollingUpdate sourced from the sibling
+	// Spec.RollingUpdate field above.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+
+	// PersistentState gives a WorkloadKindPod provider with more than one
+	// replica StatefulSet-style per-replica storage and stable identity,
+	// reconciled alongside reconcilePodSet's existing rollout/autoscaling
+	// logic. Ignored for Deployment and StatefulSet workloads, which get
+	// per-replica storage from VolumeClaimTemplates above instead.
+	// +optional
+	PersistentState *PersistentStateConfig `json:"persistentState,omitempty"`
+
+	// AdaptiveConcurrency bounds how many in-flight tool calls this
+	// provider's Pods accept using a Gradient2 limiter that backs off as
+	// observed latency grows relative to its best-seen RTT, instead of a
+	// single hand-tuned concurrency number. Unset leaves the provider
+	// unlimited.
+	// +optional
+	AdaptiveConcurrency *AdaptiveConcurrencyConfig `json:"adaptiveConcurrency,omitempty"`
+
 	// IdleTTL is the duration before an idle provider is stopped
 	// +kubebuilder:default="5m"
 	// +optional
@@ -77,6 +509,14 @@ type MCPProviderSpec struct {
 	// +optional
 	ShutdownGracePeriod string `json:"shutdownGracePeriod,omitempty"`
 
+	// PreStopDrainTimeout bounds how long the reconciler waits for
+	// HangarClient.DrainProvider to stop routing tool calls to a Pod and
+	// let in-flight ones finish before it issues the graceful Pod delete.
+	// Unset disables draining: the Pod is deleted immediately with
+	// ShutdownGracePeriod as its grace period.
+	// +optional
+	PreStopDrainTimeout string `json:"preStopDrainTimeout,omitempty"`
+
 	// HealthCheck configures health checking
 	// +optional
 	HealthCheck *HealthCheckConfig `json:"healthCheck,omitempty"`
@@ -89,6 +529,15 @@ type MCPProviderSpec struct {
 	// +optional
 	Env []EnvVar `json:"env,omitempty"`
 
+	// EnvFrom bulk-loads every key of a Secret or ConfigMap as an
+	// environment variable, for MCP servers that ship dozens of API keys
+	// and endpoints rather than a handful of settings. Sources are applied
+	// in order, each one's keys in the source's own lexical order, before
+	// Env - so an Env entry with the same name overrides a value pulled in
+	// by EnvFrom, and a later EnvFrom source overrides an earlier one.
+	// +optional
+	EnvFrom []EnvFromSource `json:"envFrom,omitempty"`
+
 	// Volumes defines volume mounts
 	// +optional
 	Volumes []Volume `json:"volumes,omitempty"`
@@ -97,6 +546,12 @@ type MCPProviderSpec struct {
 	// +optional
 	SecurityContext *SecurityContext `json:"securityContext,omitempty"`
 
+	// SecurityProfile gates the generated pod against a Kubernetes Pod
+	// Security Standards profile. Empty leaves the pod ungated beyond
+	// whatever SecurityContext fields are set above.
+	// +optional
+	SecurityProfile SecurityProfile `json:"securityProfile,omitempty"`
+
 	// ServiceAccountName is the ServiceAccount for the provider pod
 	// +optional
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
@@ -105,6 +560,20 @@ type MCPProviderSpec struct {
 	// +optional
 	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
 
+	// ImagePullPolicy for the provider container. Defaults to IfNotPresent.
+	// Tags that are mutable during development (e.g. "latest" or a branch
+	// tag rebuilt in place) should use Always so the operator doesn't pin
+	// a stale image.
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	// +optional
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// ImagePullCredentials resolves pull credentials beyond what's named in
+	// ImagePullSecrets, either by pointing at an existing dockerconfigjson
+	// Secret or by materializing one from an inline Docker config.
+	// +optional
+	ImagePullCredentials *ImagePullCredentials `json:"imagePullCredentials,omitempty"`
+
 	// NodeSelector for pod scheduling
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
@@ -125,6 +594,30 @@ type MCPProviderSpec struct {
 	// +optional
 	Tools *ToolsConfig `json:"tools,omitempty"`
 
+	// LivenessProbe determines whether the provider container should be
+	// restarted. Since provider pods use RestartPolicyNever, the
+	// reconciler interprets repeated failures as a signal to recreate
+	// the pod rather than relying on the kubelet to restart it.
+	// +optional
+	LivenessProbe *Probe `json:"livenessProbe,omitempty"`
+
+	// ReadinessProbe determines whether the provider is ready to serve
+	// tool calls. Repeated failures surface as Ready=False with reason
+	// ProbeFailed on the MCPProvider status.
+	// +optional
+	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
+
+	// StartupProbe gates the liveness and readiness probes until the
+	// provider has finished its own startup sequence.
+	// +optional
+	StartupProbe *Probe `json:"startupProbe,omitempty"`
+
+	// Lifecycle configures container lifecycle hooks, most notably
+	// PreStop, so the provider can be told to drain in-flight tool calls
+	// or send an MCP shutdown notification before SIGTERM.
+	// +optional
+	Lifecycle *Lifecycle `json:"lifecycle,omitempty"`
+
 	// CircuitBreaker configures circuit breaker behavior
 	// +optional
 	CircuitBreaker *CircuitBreakerConfig `json:"circuitBreaker,omitempty"`
@@ -132,6 +625,227 @@ type MCPProviderSpec struct {
 	// Observability configures observability features
 	// +optional
 	Observability *ObservabilityConfig `json:"observability,omitempty"`
+
+	// Sidecars are additional containers that run alongside the main
+	// provider container in the same Pod, sharing its network namespace
+	// and any Volumes they mount. Useful for a stdio-to-HTTP proxy in
+	// front of a stdio MCP server, an OAuth token-refresh sidecar, or a
+	// log/trace shipper.
+	// +optional
+	Sidecars []SidecarSpec `json:"sidecars,omitempty"`
+
+	// Helper is a well-known companion container the operator always
+	// places in the Pod alongside the main container and any Sidecars.
+	// It receives the same MCP_PROVIDER_NAME/env and Volumes as the main
+	// provider container, mirroring the "build" + "helper" pattern used
+	// by the GitLab Kubernetes executor.
+	// +optional
+	Helper *HelperSpec `json:"helper,omitempty"`
+
+	// InitContainers run to completion, in order, before the main provider
+	// container and any Sidecars start. Typical uses are cloning a
+	// git-backed MCP tool bundle into a shared emptyDir, or rendering a
+	// config file from templated Secret/ConfigMap values into a tmpfs
+	// volume so the provider container can start with
+	// ReadOnlyRootFilesystem: true intact.
+	// +optional
+	InitContainers []InitContainerSpec `json:"initContainers,omitempty"`
+
+	// InitPrefetch, when set, has the operator inject a well-known init
+	// container that pulls BundleURLs into /mcp/bundles on Volume before
+	// any user-defined InitContainers run.
+	// +optional
+	InitPrefetch *InitPrefetchSpec `json:"initPrefetch,omitempty"`
+
+	// PodTemplate is strategic-merge-patched onto the Pod
+	// BuildWorkloadForProvider builds from the rest of this spec, for
+	// fields not otherwise exposed here (extra annotations, additional
+	// tolerations not modeled by Tolerations, a raw container the rest of
+	// the spec has no field for, ...). Everything BuildWorkloadForProvider
+	// already sets from the rest of the spec - injected env vars, labels,
+	// security defaults, and the provider container's own image - always
+	// takes precedence over PodTemplate. PodTemplate may not set the
+	// provider container's image when Spec.Image is also set.
+	// +optional
+	PodTemplate *corev1.PodTemplateSpec `json:"podTemplate,omitempty"`
+}
+
+// SidecarSpec defines an additional container that runs in the same Pod as
+// the main provider container.
+type SidecarSpec struct {
+	// Name of the sidecar container
+	Name string `json:"name"`
+
+	// Image for the sidecar container
+	Image string `json:"image"`
+
+	// Command overrides the sidecar's entrypoint
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// Args are arguments to the entrypoint
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Env defines environment variables. The sidecar also receives the
+	// same MCP_PROVIDER_NAME/MCP_PROVIDER_NAMESPACE/MCP_POD_NAME/MCP_POD_IP
+	// variables as the main container.
+	// +optional
+	Env []EnvVar `json:"env,omitempty"`
+
+	// Resources defines resource requirements
+	// +optional
+	Resources *ResourceRequirements `json:"resources,omitempty"`
+
+	// VolumeMounts mounts a subset of Spec.Volumes into this container.
+	// +optional
+	VolumeMounts []VolumeMount `json:"volumeMounts,omitempty"`
+
+	// SecurityContext defines container security settings
+	// +optional
+	SecurityContext *SecurityContext `json:"securityContext,omitempty"`
+
+	// RestartPolicy set to "Always" makes this a native Kubernetes sidecar:
+	// the operator places it in the Pod's init containers with
+	// restartPolicy=Always, so the kubelet starts it before the main
+	// provider container and any user InitContainers that come after it,
+	// keeps it running for the Pod's lifetime, and restarts it on its own
+	// if it exits. Unset keeps the sidecar as a regular container that
+	// starts alongside the main container, which is sufficient for most
+	// localhost helper processes and doesn't require ordering guarantees.
+	// +kubebuilder:validation:Enum=Always
+	// +optional
+	RestartPolicy corev1.ContainerRestartPolicy `json:"restartPolicy,omitempty"`
+}
+
+// HelperSpec configures the well-known "helper" container the operator
+// injects into the Pod. Unlike a Sidecar, the helper always shares the main
+// provider container's Volume mounts, so it needs no VolumeMounts field of
+// its own.
+type HelperSpec struct {
+	// Image for the helper container
+	Image string `json:"image"`
+
+	// Command overrides the helper's entrypoint
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// Args are arguments to the entrypoint
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Env defines environment variables. The helper also receives the
+	// same MCP_PROVIDER_NAME/MCP_PROVIDER_NAMESPACE/MCP_POD_NAME/MCP_POD_IP
+	// variables as the main container.
+	// +optional
+	Env []EnvVar `json:"env,omitempty"`
+
+	// Resources defines resource requirements
+	// +optional
+	Resources *ResourceRequirements `json:"resources,omitempty"`
+
+	// SecurityContext defines container security settings
+	// +optional
+	SecurityContext *SecurityContext `json:"securityContext,omitempty"`
+}
+
+// InitContainerSpec defines a container that runs to completion before the
+// main provider container starts, sharing the same security defaults, env
+// injection, and volume mount pipeline as the main container.
+type InitContainerSpec struct {
+	// Name of the init container
+	Name string `json:"name"`
+
+	// Image for the init container
+	Image string `json:"image"`
+
+	// Command overrides the init container's entrypoint
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// Args are arguments to the entrypoint
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Env defines environment variables. The init container also receives
+	// the same MCP_PROVIDER_NAME/MCP_PROVIDER_NAMESPACE/MCP_POD_NAME/MCP_POD_IP
+	// variables as the main container.
+	// +optional
+	Env []EnvVar `json:"env,omitempty"`
+
+	// Resources defines resource requirements
+	// +optional
+	Resources *ResourceRequirements `json:"resources,omitempty"`
+
+	// VolumeMounts mounts a subset of Spec.Volumes into this container.
+	// +optional
+	VolumeMounts []VolumeMount `json:"volumeMounts,omitempty"`
+
+	// SecurityContext defines container security settings
+	// +optional
+	SecurityContext *SecurityContext `json:"securityContext,omitempty"`
+}
+
+// InitPrefetchSpec configures the operator-managed init container that
+// pre-materializes MCP tool bundles before the provider container starts.
+type InitPrefetchSpec struct {
+	// BundleURLs lists the oci:// or https:// tool bundle URLs to fetch
+	// into /mcp/bundles on Volume.
+	BundleURLs []string `json:"bundleURLs"`
+
+	// Volume names the Spec.Volumes entry the fetched bundles are written
+	// to, typically an emptyDir shared with the main provider container.
+	Volume string `json:"volume"`
+
+	// Image overrides the default bundle-prefetcher image.
+	// +optional
+	Image string `json:"image,omitempty"`
+}
+
+// ImagePullCredentials resolves registry credentials for Spec.Image beyond
+// what ImagePullSecrets names directly.
+type ImagePullCredentials struct {
+	// SecretRef names an existing Secret of type kubernetes.io/dockerconfigjson
+	// to attach to the pod, equivalent to adding it to ImagePullSecrets.
+	// +optional
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+
+	// ConfigMapRef names a ConfigMap holding an inline Docker credential
+	// helper config (a single key whose value is a {"auths": {...}}
+	// document) that the operator materializes into a generated
+	// kubernetes.io/dockerconfigjson Secret owned by the MCPProvider.
+	// +optional
+	ConfigMapRef *ImagePullCredentialsConfigMapRef `json:"configMapRef,omitempty"`
+}
+
+// ImagePullCredentialsConfigMapRef selects the ConfigMap and key
+// ImagePullCredentials.ConfigMapRef materializes into a pull Secret.
+type ImagePullCredentialsConfigMapRef struct {
+	// Name of the ConfigMap
+	Name string `json:"name"`
+
+	// Key holding the {"auths": {...}} document. Defaults to "auths".
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// VolumeMount references a Volume defined in Spec.Volumes by name, letting
+// a Sidecar or InitContainer mount only the volumes it needs at a path of
+// its own choosing.
+type VolumeMount struct {
+	// Name of the Volume to mount
+	Name string `json:"name"`
+
+	// MountPath within the container
+	MountPath string `json:"mountPath"`
+
+	// SubPath within the volume
+	// +optional
+	SubPath string `json:"subPath,omitempty"`
+
+	// ReadOnly mount
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
 }
 
 // HealthCheckConfig defines health check settings
@@ -159,6 +873,179 @@ type HealthCheckConfig struct {
 	SuccessThreshold int32 `json:"successThreshold,omitempty"`
 }
 
+// Probe defines a liveness, readiness, or startup probe for the provider
+// container. At most one of HTTPGet, TCPSocket, GRPC, Exec, or MCPInitialize
+// may be set; if none are set, the probe falls back to an MCPInitialize ping
+// with default settings, since every MCP provider already speaks the
+// initialize/ping request regardless of how its own health endpoint (if any)
+// is wired up.
+type Probe struct {
+	// HTTPGet probes an HTTP endpoint on the container
+	// +optional
+	HTTPGet *HTTPGetAction `json:"httpGet,omitempty"`
+
+	// TCPSocket probes a TCP port on the container
+	// +optional
+	TCPSocket *TCPSocketAction `json:"tcpSocket,omitempty"`
+
+	// GRPC probes a gRPC health check endpoint on the container
+	// +optional
+	GRPC *GRPCAction `json:"grpc,omitempty"`
+
+	// Exec runs a command inside the container
+	// +optional
+	Exec *ExecAction `json:"exec,omitempty"`
+
+	// MCPInitialize is a convenience probe that issues an MCP `initialize`
+	// JSON-RPC request over the provider's declared transport and checks
+	// for a valid `serverInfo` response, instead of a raw HTTPGet/TCPSocket
+	// health check. The operator translates it into an Exec probe that
+	// runs a bundled probe binary against the container.
+	// +optional
+	MCPInitialize *MCPInitializeAction `json:"mcpInitialize,omitempty"`
+
+	// InitialDelaySeconds before the probe is first executed
+	// +optional
+	InitialDelaySeconds int32 `json:"initialDelaySeconds,omitempty"`
+
+	// PeriodSeconds between probe executions
+	// +kubebuilder:default=10
+	// +optional
+	PeriodSeconds int32 `json:"periodSeconds,omitempty"`
+
+	// TimeoutSeconds after which the probe is considered failed
+	// +kubebuilder:default=1
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+
+	// FailureThreshold is the number of consecutive failures before the
+	// probe is considered failed
+	// +kubebuilder:default=3
+	// +optional
+	FailureThreshold int32 `json:"failureThreshold,omitempty"`
+
+	// SuccessThreshold is the number of consecutive successes before the
+	// probe is considered successful after having failed
+	// +kubebuilder:default=1
+	// +optional
+	SuccessThreshold int32 `json:"successThreshold,omitempty"`
+}
+
+// HTTPGetAction describes an HTTP probe
+type HTTPGetAction struct {
+	// Path to request
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Port to probe
+	Port int32 `json:"port"`
+
+	// Host to connect to, defaults to the pod IP
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	// Scheme to use, HTTP or HTTPS
+	// +kubebuilder:validation:Enum=HTTP;HTTPS
+	// +kubebuilder:default=HTTP
+	// +optional
+	Scheme string `json:"scheme,omitempty"`
+
+	// HTTPHeaders to set in the request
+	// +optional
+	HTTPHeaders []HTTPHeader `json:"httpHeaders,omitempty"`
+}
+
+// HTTPHeader is a name/value pair added to an HTTP probe request
+type HTTPHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// TCPSocketAction describes a TCP probe
+type TCPSocketAction struct {
+	// Port to probe
+	Port int32 `json:"port"`
+
+	// Host to connect to, defaults to the pod IP
+	// +optional
+	Host string `json:"host,omitempty"`
+}
+
+// GRPCAction describes a gRPC health check probe
+type GRPCAction struct {
+	// Port to probe
+	Port int32 `json:"port"`
+
+	// Service is the name of the service to place in the health check
+	// request, defaulting to the overall server status
+	// +optional
+	Service *string `json:"service,omitempty"`
+}
+
+// ExecAction runs a command inside the container
+type ExecAction struct {
+	// Command is the command line to execute
+	Command []string `json:"command,omitempty"`
+}
+
+// MCPInitializeAction describes an MCP-aware probe that sends a JSON-RPC
+// `{"jsonrpc": "2.0", "method": "initialize"}` request over the provider's
+// transport and considers the probe successful only if the response
+// contains a `serverInfo` object.
+type MCPInitializeAction struct {
+	// Transport the probe binary should speak to the provider
+	// +kubebuilder:validation:Enum=stdio;http
+	// +kubebuilder:default=stdio
+	// +optional
+	Transport string `json:"transport,omitempty"`
+
+	// Port the provider serves the MCP HTTP transport on. Required when
+	// Transport is "http".
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// Path is the HTTP endpoint the initialize request is POSTed to.
+	// +kubebuilder:default="/rpc"
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// Lifecycle defines actions taken around the provider container's
+// lifecycle events.
+type Lifecycle struct {
+	// PostStart is called immediately after the container is created. The
+	// container is not marked Running until PostStart completes, so a slow
+	// or failing hook delays startup rather than silently racing it.
+	// +optional
+	PostStart *LifecycleHandler `json:"postStart,omitempty"`
+
+	// PreStop is called immediately before the container is terminated,
+	// blocking termination until it completes or ShutdownGracePeriod
+	// elapses.
+	// +optional
+	PreStop *LifecycleHandler `json:"preStop,omitempty"`
+}
+
+// LifecycleHandler defines a lifecycle hook action. Exactly one of Exec or
+// HTTPGet must be set.
+type LifecycleHandler struct {
+	// Exec runs a command inside the container
+	// +optional
+	Exec *ExecAction `json:"exec,omitempty"`
+
+	// HTTPGet probes an HTTP endpoint on the container
+	// +optional
+	HTTPGet *HTTPGetAction `json:"httpGet,omitempty"`
+
+	// TimeoutSeconds bounds how long the hook may run before it is
+	// abandoned. Informational only for Exec hooks, since the kubelet
+	// itself does not enforce a timeout on them; the reconciler uses it
+	// to warn when ShutdownGracePeriod is too short to let the hook
+	// finish.
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
 // ResourceRequirements defines resource requests and limits
 type ResourceRequirements struct {
 	Requests *ResourceList `json:"requests,omitempty"`
@@ -185,10 +1072,80 @@ type EnvVar struct {
 	ValueFrom *EnvVarSource `json:"valueFrom,omitempty"`
 }
 
+// EnvFromSource bulk-loads every key of a Secret or ConfigMap as an
+// environment variable. Exactly one of SecretRef or ConfigMapRef must be
+// set, matching Kubernetes' own envFrom.
+type EnvFromSource struct {
+	// Prefix is prepended to each imported key to form the environment
+	// variable name
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// SecretRef imports every key of a Secret
+	// +optional
+	SecretRef *SecretEnvSource `json:"secretRef,omitempty"`
+
+	// ConfigMapRef imports every key of a ConfigMap
+	// +optional
+	ConfigMapRef *ConfigMapEnvSource `json:"configMapRef,omitempty"`
+}
+
+// SecretEnvSource references a Secret whose keys are all imported as
+// environment variables.
+type SecretEnvSource struct {
+	Name string `json:"name"`
+
+	// Optional marks the Secret as not required to exist
+	// +optional
+	Optional *bool `json:"optional,omitempty"`
+}
+
+// ConfigMapEnvSource references a ConfigMap whose keys are all imported as
+// environment variables.
+type ConfigMapEnvSource struct {
+	Name string `json:"name"`
+
+	// Optional marks the ConfigMap as not required to exist
+	// +optional
+	Optional *bool `json:"optional,omitempty"`
+}
+
 // EnvVarSource defines the source for an environment variable value
 type EnvVarSource struct {
 	SecretKeyRef    *SecretKeySelector    `json:"secretKeyRef,omitempty"`
 	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// FieldRef selects a field of the pod, e.g. metadata.name or status.podIP
+	// +optional
+	FieldRef *ObjectFieldSelector `json:"fieldRef,omitempty"`
+
+	// ResourceFieldRef selects a container resource, e.g. limits.cpu
+	// +optional
+	ResourceFieldRef *ResourceFieldSelector `json:"resourceFieldRef,omitempty"`
+}
+
+// ObjectFieldSelector selects a field of the pod for the downward API.
+type ObjectFieldSelector struct {
+	// FieldPath is the path of the field to select
+	// +kubebuilder:validation:Enum=metadata.name;metadata.namespace;metadata.uid;metadata.labels;metadata.annotations;status.podIP;status.hostIP;spec.nodeName;spec.serviceAccountName
+	FieldPath string `json:"fieldPath"`
+}
+
+// ResourceFieldSelector selects a container resource quantity for the
+// downward API, e.g. limits.cpu or requests.memory.
+type ResourceFieldSelector struct {
+	// ContainerName defaults to the provider's own container when empty.
+	// +optional
+	ContainerName string `json:"containerName,omitempty"`
+
+	// Resource is the resource to select
+	// +kubebuilder:validation:Enum=limits.cpu;limits.memory;limits.ephemeral-storage;requests.cpu;requests.memory;requests.ephemeral-storage
+	Resource string `json:"resource"`
+
+	// Divisor the resource value is expressed in, e.g. "1" or "1Mi". Defaults
+	// to a sensible per-resource unit when omitted.
+	// +optional
+	Divisor string `json:"divisor,omitempty"`
 }
 
 // SecretKeySelector selects a key from a Secret
@@ -236,6 +1193,18 @@ type Volume struct {
 	// EmptyDir volume source
 	// +optional
 	EmptyDir *EmptyDirVolumeSource `json:"emptyDir,omitempty"`
+
+	// Ephemeral volume source. The kubelet auto-provisions a PVC scoped to
+	// the pod's lifecycle from VolumeClaimTemplate; requires the
+	// GenericEphemeralVolume feature to be enabled on the cluster.
+	// +optional
+	Ephemeral *EphemeralVolumeSource `json:"ephemeral,omitempty"`
+
+	// DownwardAPI projects pod and container fields into files under this
+	// volume's mount path, letting a tool container read them from disk
+	// instead of an env var.
+	// +optional
+	DownwardAPI *DownwardAPIVolumeSource `json:"downwardAPI,omitempty"`
 }
 
 // SecretVolumeSource adapts a Secret
@@ -261,6 +1230,50 @@ type EmptyDirVolumeSource struct {
 	SizeLimit string `json:"sizeLimit,omitempty"`
 }
 
+// EphemeralVolumeSource provisions a PVC scoped to the pod's lifecycle from
+// an inline template, mirroring Kubernetes' generic ephemeral volumes.
+type EphemeralVolumeSource struct {
+	// VolumeClaimTemplate describes the PVC the kubelet will create for this
+	// volume, named "<pod>-<volume>". It intentionally has no claimName
+	// field: that name is always derived, never chosen by the user.
+	VolumeClaimTemplate EphemeralVolumeClaimTemplate `json:"volumeClaimTemplate"`
+}
+
+// EphemeralVolumeClaimTemplate is the subset of a PersistentVolumeClaimSpec
+// needed to provision a generic ephemeral volume.
+type EphemeralVolumeClaimTemplate struct {
+	// StorageClassName of the auto-provisioned PVC
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// AccessModes for the auto-provisioned PVC
+	AccessModes []string `json:"accessModes"`
+
+	// Storage capacity requested, e.g. "1Gi"
+	Storage string `json:"storage"`
+}
+
+// DownwardAPIVolumeSource projects pod and container fields into files
+// under the volume's mount path, one file per item.
+type DownwardAPIVolumeSource struct {
+	Items []DownwardAPIVolumeFile `json:"items"`
+}
+
+// DownwardAPIVolumeFile maps a single pod or container field to a file.
+// Exactly one of FieldRef or ResourceFieldRef must be set.
+type DownwardAPIVolumeFile struct {
+	// Path of the file to create, relative to the volume's mount point
+	Path string `json:"path"`
+
+	// FieldRef selects a field of the pod
+	// +optional
+	FieldRef *ObjectFieldSelector `json:"fieldRef,omitempty"`
+
+	// ResourceFieldRef selects a container resource
+	// +optional
+	ResourceFieldRef *ResourceFieldSelector `json:"resourceFieldRef,omitempty"`
+}
+
 // KeyToPath defines a key to path mapping
 type KeyToPath struct {
 	Key  string `json:"key"`
@@ -277,6 +1290,15 @@ type SecurityContext struct {
 	AllowPrivilegeEscalation *bool           `json:"allowPrivilegeEscalation,omitempty"`
 	Capabilities             *Capabilities   `json:"capabilities,omitempty"`
 	SeccompProfile           *SeccompProfile `json:"seccompProfile,omitempty"`
+	SELinuxOptions           *SELinuxOptions `json:"seLinuxOptions,omitempty"`
+
+	// AppArmorProfile selects the AppArmor profile applied to this
+	// container. Unlike the other fields here, the kubelet reads this off
+	// a Pod annotation rather than a SecurityContext field, so the builder
+	// surfaces it as container.apparmor.security.beta.kubernetes.io/<name>
+	// on the Pod template instead of on corev1.SecurityContext.
+	// +optional
+	AppArmorProfile *AppArmorProfile `json:"appArmorProfile,omitempty"`
 }
 
 // Capabilities defines Linux capabilities
@@ -287,7 +1309,37 @@ type Capabilities struct {
 
 // SeccompProfile defines seccomp settings
 type SeccompProfile struct {
+	// Type is one of RuntimeDefault, Localhost, or Unconfined
+	Type string `json:"type,omitempty"`
+
+	// LocalhostProfile names a profile file on the node's disk when Type
+	// is Localhost, relative to the kubelet's configured seccomp profile
+	// root.
+	// +optional
+	LocalhostProfile string `json:"localhostProfile,omitempty"`
+}
+
+// SELinuxOptions are the SELinux labels applied to a Pod or container
+type SELinuxOptions struct {
+	// +optional
+	User string `json:"user,omitempty"`
+	// +optional
+	Role string `json:"role,omitempty"`
+	// +optional
 	Type string `json:"type,omitempty"`
+	// +optional
+	Level string `json:"level,omitempty"`
+}
+
+// AppArmorProfile selects an AppArmor profile for a container.
+type AppArmorProfile struct {
+	// Type is one of RuntimeDefault, Localhost, or Unconfined
+	Type string `json:"type,omitempty"`
+
+	// LocalhostProfile names a profile loaded on the node when Type is
+	// Localhost.
+	// +optional
+	LocalhostProfile string `json:"localhostProfile,omitempty"`
 }
 
 // Toleration defines a pod toleration
@@ -317,6 +1369,54 @@ type RateLimitConfig struct {
 	BurstSize         int32 `json:"burstSize,omitempty"`
 }
 
+// RestartPolicy configures how many times the reconciler restarts a
+// failing Pod before opening the circuit, and how the cooldown between
+// half-open retries grows while the provider keeps failing.
+type RestartPolicy struct {
+	// MaxFailures is the number of consecutive Pod failures before the
+	// circuit opens and the reconciler stops restarting immediately.
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxFailures int32 `json:"maxFailures,omitempty"`
+
+	// InitialCooldown is how long the circuit stays open before the first
+	// half-open retry.
+	// +kubebuilder:default="10s"
+	// +optional
+	InitialCooldown string `json:"initialCooldown,omitempty"`
+
+	// MaxCooldown caps how long the cooldown may grow to after repeated
+	// half-open failures.
+	// +kubebuilder:default="5m"
+	// +optional
+	MaxCooldown string `json:"maxCooldown,omitempty"`
+
+	// BackoffMultiplier scales the cooldown on each half-open failure.
+	// +kubebuilder:default="2"
+	// +optional
+	BackoffMultiplier float64 `json:"backoffMultiplier,omitempty"`
+}
+
+// RollingUpdateConfig bounds a WorkloadKindPod rollout the same way
+// appsv1.RollingUpdateDeployment bounds a Deployment's: MaxSurge allows that
+// many Pods above Replicas while new-template Pods come up, MaxUnavailable
+// allows that many below Replicas while old-template Pods are torn down.
+// Both accept an absolute count or a percentage of Replicas.
+type RollingUpdateConfig struct {
+	// MaxSurge is the maximum number of Pods that can be created above
+	// Replicas during a rollout.
+	// +kubebuilder:default="25%"
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// MaxUnavailable is the maximum number of Pods that can be unavailable
+	// below Replicas during a rollout.
+	// +kubebuilder:default="25%"
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
 // CircuitBreakerConfig defines circuit breaker settings
 type CircuitBreakerConfig struct {
 	// Enabled enables circuit breaker
@@ -375,6 +1475,41 @@ type MCPProviderStatus struct {
 	// AvailableReplicas is the number of available replicas
 	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
 
+	// UpdatedReplicas is the number of Pods already on the current Pod
+	// template hash, tracked for a WorkloadKindPod provider rolling out
+	// under RolloutStrategy.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+
+	// RolloutPhase summarizes where a WorkloadKindPod provider's
+	// RolloutStrategy rollout currently is. Empty when no rollout is in
+	// progress.
+	// +optional
+	RolloutPhase RolloutPhase `json:"rolloutPhase,omitempty"`
+
+	// DesiredReplicas is Spec.Autoscaling's most recent recommendation,
+	// clamped to MinReplicas/MaxReplicas. Unset when Autoscaling is unset.
+	// +optional
+	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
+
+	// LastScaleTime is when the reconciler last changed Replicas on
+	// Spec.Autoscaling's recommendation, gating ScaleUpStabilization and
+	// ScaleDownStabilization against further changes.
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+
+	// CurrentRevision is the Pod template hash every ordinal from 0 up to
+	// Replicas-1 is currently running, mirroring StatefulSet's own
+	// CurrentRevision. It only advances once a PersistentState provider's
+	// rollout has replaced every stale-hash ordinal.
+	// +optional
+	CurrentRevision string `json:"currentRevision,omitempty"`
+
+	// UpdateRevision is the Pod template hash a PersistentState provider
+	// is rolling toward, mirroring StatefulSet's own UpdateRevision.
+	// +optional
+	UpdateRevision string `json:"updateRevision,omitempty"`
+
 	// ToolsCount is the number of exposed tools
 	ToolsCount int32 `json:"toolsCount,omitempty"`
 
@@ -396,16 +1531,43 @@ type MCPProviderStatus struct {
 	// ConsecutiveFailures counts consecutive health failures
 	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
 
+	// LastFailureAt is when the Pod was last observed Failed.
+	// +optional
+	LastFailureAt *metav1.Time `json:"lastFailureAt,omitempty"`
+
+	// CooldownUntil is when the circuit breaker next allows a half-open
+	// retry. Only meaningful while State is CircuitOpen.
+	// +optional
+	CooldownUntil *metav1.Time `json:"cooldownUntil,omitempty"`
+
 	// ObservedGeneration is the generation observed by controller
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 
 	// PodName is the name of the managed Pod
 	PodName string `json:"podName,omitempty"`
 
+	// PullStatus tracks image pull retry state while the Pod is stuck in
+	// ImagePullBackOff/ErrImagePull. Cleared once the image pulls
+	// successfully.
+	// +optional
+	PullStatus *PullStatus `json:"pullStatus,omitempty"`
+
 	// Conditions represent the latest available observations
 	Conditions []Condition `json:"conditions,omitempty"`
 }
 
+// PullStatus tracks image pull retry state for a container-mode provider.
+type PullStatus struct {
+	// Attempts counts consecutive pull failures observed by the reconciler.
+	Attempts int32 `json:"attempts,omitempty"`
+
+	// LastError is the most recent pull failure message reported by the kubelet.
+	LastError string `json:"lastError,omitempty"`
+
+	// LastAttemptTime is when LastError was last observed.
+	LastAttemptTime *metav1.Time `json:"lastAttemptTime,omitempty"`
+}
+
 // Condition represents a condition of a resource
 type Condition struct {
 	// Type of condition
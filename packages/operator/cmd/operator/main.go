@@ -2,12 +2,18 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
+	"net/http"
 	"os"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	"go.opentelemetry.io/otel"
+
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -17,8 +23,11 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+	mcpv1beta1 "github.com/mapyr/mcp-hangar/operator/api/v1beta1"
 	"github.com/mapyr/mcp-hangar/operator/internal/controller"
+	"github.com/mapyr/mcp-hangar/operator/pkg/featuregate"
 	"github.com/mapyr/mcp-hangar/operator/pkg/hangar"
+	"github.com/mapyr/mcp-hangar/operator/pkg/metrics"
 )
 
 var (
@@ -29,15 +38,34 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(mcpv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(mcpv1beta1.AddToScheme(scheme))
 }
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "play-kube":
+			runPlayKube(os.Args[2:])
+			return
+		case "generate-kube":
+			runGenerateKube(os.Args[2:])
+			return
+		}
+	}
+
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
 	var hangarURL string
 	var hangarAPIKey string
 	var logLevel string
+	var metricsExporter string
+	var otlpEndpoint string
+	var otlpProtocol string
+	var otlpInsecure bool
+	var otlpSamplingRatio float64
+	var enableNativeHistograms bool
+	var featureGatesFlag string
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -47,6 +75,22 @@ func main() {
 	flag.StringVar(&hangarURL, "hangar-url", "", "URL of MCP-Hangar core service")
 	flag.StringVar(&hangarAPIKey, "hangar-api-key", "", "API key for MCP-Hangar core")
 	flag.StringVar(&logLevel, "log-level", "info", "Log level (debug, info, warn, error)")
+	flag.StringVar(&metricsExporter, "metrics-exporter", "prometheus",
+		"Where to send metrics and traces: prometheus, otlp, or both. otlp/both also require --otlp-endpoint "+
+			"(or OTEL_EXPORTER_OTLP_ENDPOINT).")
+	flag.StringVar(&otlpEndpoint, "otlp-endpoint", "", "OTLP collector endpoint (overridden by OTEL_EXPORTER_OTLP_ENDPOINT if unset)")
+	flag.StringVar(&otlpProtocol, "otlp-protocol", "", "OTLP protocol: grpc or http/protobuf (default grpc)")
+	flag.BoolVar(&otlpInsecure, "otlp-insecure", false, "Disable TLS on the OTLP connection")
+	flag.Float64Var(&otlpSamplingRatio, "otlp-sampling-ratio", 0, "Fraction of traces to sample, in [0,1] (default 1)")
+	flag.BoolVar(&enableNativeHistograms, "enable-native-histograms", false,
+		"Switch ReconcileDuration, HangarClientLatency, and DiscoverySyncDuration to Prometheus native "+
+			"(sparse) histograms instead of fixed exponential buckets.")
+	flag.StringVar(&featureGatesFlag, "feature-gates", "",
+		"Comma-separated Name=Level pairs acknowledging experimental discovery gates, e.g. "+
+			"ConsulDiscovery=Alpha,DNSDiscovery=Alpha. See pkg/featuregate.Registry for the full list "+
+			"and their registered levels. No MCPDiscoverySource reconciler exists in this build yet, "+
+			"so enabling a gate here only changes its reported state on /featuregates and the "+
+			"feature_gate_enabled metric - it does not unblock the discovery backend it guards.")
 
 	opts := zap.Options{
 		Development: logLevel == "debug",
@@ -64,10 +108,49 @@ func main() {
 		hangarAPIKey = envKey
 	}
 
+	if enableNativeHistograms {
+		metrics.EnableNativeHistograms()
+	}
+
+	otlpShutdown, err := metrics.EnableOTel(context.Background(), metrics.OTLPConfigFromEnv(metrics.OTLPConfig{
+		Mode:          metrics.ExporterMode(metricsExporter),
+		Endpoint:      otlpEndpoint,
+		Protocol:      otlpProtocol,
+		Insecure:      otlpInsecure,
+		SamplingRatio: otlpSamplingRatio,
+	}))
+	if err != nil {
+		setupLog.Error(err, "unable to start OTLP exporter")
+		os.Exit(1)
+	}
+	defer func() {
+		if err := otlpShutdown(context.Background()); err != nil {
+			setupLog.Error(err, "error shutting down OTLP exporter")
+		}
+	}()
+
+	gates, err := featuregate.InitializeWithTimeout(context.Background(), featureGatesFlag, 10*time.Second)
+	if err != nil {
+		setupLog.Error(err, "unable to initialize feature gates")
+		os.Exit(1)
+	}
+	for _, snap := range gates.Snapshot() {
+		metrics.SetFeatureGateEnabled(snap.Name, string(snap.Level), snap.Enabled)
+		setupLog.Info("feature gate", "name", snap.Name, "level", snap.Level, "enabled", snap.Enabled)
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme: scheme,
 		Metrics: metricsserver.Options{
 			BindAddress: metricsAddr,
+			ExtraHandlers: map[string]http.Handler{
+				"/featuregates": http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					if err := json.NewEncoder(w).Encode(gates.Snapshot()); err != nil {
+						setupLog.Error(err, "unable to encode feature gate snapshot")
+					}
+				}),
+			},
 		},
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
@@ -81,10 +164,16 @@ func main() {
 	// Initialize Hangar client (optional)
 	var hangarClient *hangar.Client
 	if hangarURL != "" {
-		hangarClient = hangar.NewClient(&hangar.Config{
-			URL:    hangarURL,
-			APIKey: hangarAPIKey,
+		hangarClient, err = hangar.NewClient(&hangar.Config{
+			URL:            hangarURL,
+			APIKey:         hangarAPIKey,
+			TracerProvider: otel.GetTracerProvider(),
+			MeterProvider:  otel.GetMeterProvider(),
 		})
+		if err != nil {
+			setupLog.Error(err, "unable to create hangar client")
+			os.Exit(1)
+		}
 		setupLog.Info("Hangar client configured", "url", hangarURL)
 	} else {
 		setupLog.Info("Hangar client not configured - running without Hangar core integration")
@@ -102,6 +191,18 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Conversion webhook between v1alpha1 and v1beta1 MCPProviderGroup
+	if err := (&mcpv1beta1.MCPProviderGroup{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "MCPProviderGroup")
+		os.Exit(1)
+	}
+
+	// Conversion webhook between v1alpha1 and v1beta1 MCPDiscoverySource
+	if err := (&mcpv1beta1.MCPDiscoverySource{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "MCPDiscoverySource")
+		os.Exit(1)
+	}
+
 	// Health checks
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+	"github.com/mapyr/mcp-hangar/operator/pkg/playkube"
+)
+
+// runPlayKube implements `mcp-hangar-operator play-kube <file.yaml>`: it
+// ingests a Pod or single-container Deployment manifest and prints the
+// equivalent MCPProvider as YAML on stdout.
+func runPlayKube(args []string) {
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: mcp-hangar-operator play-kube <file.yaml>")
+		os.Exit(2)
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "reading %s: %v\n", args[0], err)
+		os.Exit(1)
+	}
+
+	mcpProvider, err := playkube.Import(data)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "converting %s: %v\n", args[0], err)
+		os.Exit(1)
+	}
+
+	out, err := yaml.Marshal(mcpProvider)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "marshaling MCPProvider: %v\n", err)
+		os.Exit(1)
+	}
+	os.Stdout.Write(out)
+}
+
+// runGenerateKube implements `mcp-hangar-operator generate-kube <file.yaml>`:
+// it reads an MCPProvider manifest and prints the plain Pod it would build,
+// for offline debugging with `kubectl apply`.
+func runGenerateKube(args []string) {
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: mcp-hangar-operator generate-kube <file.yaml>")
+		os.Exit(2)
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "reading %s: %v\n", args[0], err)
+		os.Exit(1)
+	}
+
+	var mcpProvider mcpv1alpha1.MCPProvider
+	if err := yaml.Unmarshal(data, &mcpProvider); err != nil {
+		fmt.Fprintf(os.Stderr, "parsing %s: %v\n", args[0], err)
+		os.Exit(1)
+	}
+
+	out, err := playkube.Generate(&mcpProvider)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "generating pod: %v\n", err)
+		os.Exit(1)
+	}
+	os.Stdout.Write(out)
+}
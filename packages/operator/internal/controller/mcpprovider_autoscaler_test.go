@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+	"github.com/mapyr/mcp-hangar/operator/pkg/hangar"
+	"github.com/mapyr/mcp-hangar/operator/pkg/provider"
+)
+
+// autoscalingServer serves a fixed ActiveToolCalls value from
+// GetProviderMetrics, the same way client_test.go's other hangar tests
+// stand up an httptest.Server rather than mocking hangar.Client directly.
+func autoscalingServer(t *testing.T, activeToolCalls int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(hangar.ProviderMetrics{ActiveToolCalls: activeToolCalls})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func autoscalingProvider(minReplicas, maxReplicas int32) *mcpv1alpha1.MCPProvider {
+	replicas := minReplicas
+	return &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+			UID:       "test-uid-123",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:         mcpv1alpha1.ProviderModeContainer,
+			Image:        "test-image:v1",
+			WorkloadKind: mcpv1alpha1.WorkloadKindDeployment,
+			Replicas:     &replicas,
+			Autoscaling: &mcpv1alpha1.AutoscalingConfig{
+				MinReplicas: &minReplicas,
+				MaxReplicas: maxReplicas,
+				Metrics: []mcpv1alpha1.MetricSpec{
+					{
+						Type:   mcpv1alpha1.MetricSourceMCPToolConcurrency,
+						Target: mcpv1alpha1.MetricTarget{Type: mcpv1alpha1.MetricTargetValue, Value: "10"},
+					},
+				},
+			},
+		},
+	}
+}
+
+// TestReconcileAutoscaling_ScalesDeploymentAcrossTwoReconciles covers the
+// chunk4-6 fix: reconcileAutoscaling's replica recommendation must actually
+// reach a Deployment-backed provider's running workload, not just
+// Spec.Replicas on the MCPProvider object. The first reconcile creates the
+// Deployment at MinReplicas; raising the observed metric and reconciling a
+// second time must move the Deployment's spec.replicas up too.
+func TestReconcileAutoscaling_ScalesDeploymentAcrossTwoReconciles(t *testing.T) {
+	ctx := context.Background()
+	mcpProvider := autoscalingProvider(1, 5)
+	r := newTestReconciler(t, mcpProvider)
+
+	desired, err := provider.BuildWorkloadForProvider(mcpProvider)
+	require.NoError(t, err)
+	_, err = r.reconcileScalableWorkload(ctx, mcpProvider, desired)
+	require.NoError(t, err)
+
+	var deployment appsv1.Deployment
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(desired), &deployment))
+	require.NotNil(t, deployment.Spec.Replicas)
+	assert.Equal(t, int32(1), *deployment.Spec.Replicas)
+
+	// Observe a metric well above target so the next reconcile recommends
+	// scaling up, then rerun both autoscaling and the workload reconcile
+	// the way reconcileContainerProvider chains them.
+	server := autoscalingServer(t, 40) // 40 / 10 target => recommend 4 replicas
+	hangarClient, err := hangar.NewClient(&hangar.Config{URL: server.URL})
+	require.NoError(t, err)
+	r.HangarClient = hangarClient
+
+	require.NoError(t, r.reconcileAutoscaling(ctx, mcpProvider))
+	require.NotNil(t, mcpProvider.Spec.Replicas)
+	assert.Equal(t, int32(4), *mcpProvider.Spec.Replicas)
+
+	desired, err = provider.BuildWorkloadForProvider(mcpProvider)
+	require.NoError(t, err)
+	_, err = r.reconcileScalableWorkload(ctx, mcpProvider, desired)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(desired), &deployment))
+	require.NotNil(t, deployment.Spec.Replicas)
+	assert.Equal(t, int32(4), *deployment.Spec.Replicas, "autoscaling's recommendation must reach the running Deployment")
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+func failedPod(mcpProvider *mcpv1alpha1.MCPProvider) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mcpProvider.GetPodName(),
+			Namespace: mcpProvider.Namespace,
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodFailed,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{
+							Reason:  "Error",
+							Message: "boom",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// TestHandlePodFailed_BelowMaxFailures_DeletesAndBackoffs covers a failure
+// that hasn't yet tripped the circuit: the Pod is deleted for a restart and
+// the reconciler backs off rather than opening the circuit.
+func TestHandlePodFailed_BelowMaxFailures_DeletesAndBackoffs(t *testing.T) {
+	ctx := context.Background()
+	mcpProvider := podModeProvider(1)
+	mcpProvider.Spec.RestartPolicy = &mcpv1alpha1.RestartPolicy{MaxFailures: 3}
+	pod := failedPod(mcpProvider)
+	r := newTestReconciler(t, mcpProvider, pod)
+
+	backoff := r.handlePodFailed(ctx, mcpProvider, pod)
+
+	assert.Equal(t, int32(1), mcpProvider.Status.ConsecutiveFailures)
+	assert.Equal(t, mcpv1alpha1.ProviderStateDead, mcpProvider.Status.State)
+	assert.Equal(t, 10*time.Second, backoff)
+
+	err := r.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})
+	assert.True(t, errors.IsNotFound(err), "failed pod should have been deleted for restart")
+}
+
+// TestHandlePodFailed_ReachesMaxFailures_OpensCircuit covers the transition
+// into ProviderStateCircuitOpen once ConsecutiveFailures reaches
+// RestartPolicy.MaxFailures.
+func TestHandlePodFailed_ReachesMaxFailures_OpensCircuit(t *testing.T) {
+	ctx := context.Background()
+	mcpProvider := podModeProvider(1)
+	mcpProvider.Spec.RestartPolicy = &mcpv1alpha1.RestartPolicy{MaxFailures: 1}
+	pod := failedPod(mcpProvider)
+	r := newTestReconciler(t, mcpProvider, pod)
+
+	cooldown := r.handlePodFailed(ctx, mcpProvider, pod)
+
+	assert.Equal(t, mcpv1alpha1.ProviderStateCircuitOpen, mcpProvider.Status.State)
+	require.NotNil(t, mcpProvider.Status.CooldownUntil)
+	assert.Greater(t, cooldown, time.Duration(0))
+}
+
+// TestHandlePodFailed_AlreadyOpen_WaitsOutCooldown covers the already-open
+// branch: while the cooldown hasn't elapsed, handlePodFailed must not
+// re-count the failure and should just return the time left.
+func TestHandlePodFailed_AlreadyOpen_WaitsOutCooldown(t *testing.T) {
+	ctx := context.Background()
+	mcpProvider := podModeProvider(1)
+	mcpProvider.Spec.RestartPolicy = &mcpv1alpha1.RestartPolicy{MaxFailures: 1}
+	mcpProvider.Status.State = mcpv1alpha1.ProviderStateCircuitOpen
+	mcpProvider.Status.ConsecutiveFailures = 1
+	cooldownUntil := metav1.NewTime(time.Now().Add(1 * time.Minute))
+	mcpProvider.Status.CooldownUntil = &cooldownUntil
+	pod := failedPod(mcpProvider)
+	r := newTestReconciler(t, mcpProvider, pod)
+
+	remaining := r.handlePodFailed(ctx, mcpProvider, pod)
+
+	assert.Equal(t, int32(1), mcpProvider.Status.ConsecutiveFailures, "waiting out an open circuit must not re-count the failure")
+	assert.Equal(t, mcpv1alpha1.ProviderStateCircuitOpen, mcpProvider.Status.State)
+	assert.Greater(t, remaining, time.Duration(0))
+	assert.LessOrEqual(t, remaining, 1*time.Minute)
+}
+
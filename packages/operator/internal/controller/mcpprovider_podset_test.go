@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+	"github.com/mapyr/mcp-hangar/operator/pkg/provider"
+)
+
+func podModeProvider(replicas int32) *mcpv1alpha1.MCPProvider {
+	return &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+			UID:       "test-uid-123",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:         mcpv1alpha1.ProviderModeContainer,
+			Image:        "test-image:v1",
+			WorkloadKind: mcpv1alpha1.WorkloadKindPod,
+			Replicas:     &replicas,
+		},
+	}
+}
+
+// TestReconcilePodSet_CreatesAllReplicasFromScratch covers the basic
+// scale-up path: with no existing Pods, reconcilePodSet should create one
+// Pod per desired ordinal, up to the rollout's surge budget.
+func TestReconcilePodSet_CreatesAllReplicasFromScratch(t *testing.T) {
+	ctx := context.Background()
+	mcpProvider := podModeProvider(3)
+	r := newTestReconciler(t, mcpProvider)
+
+	desiredPods, err := provider.BuildPodsForProvider(mcpProvider)
+	require.NoError(t, err)
+
+	_, err = r.reconcilePodSet(ctx, mcpProvider, desiredPods)
+	require.NoError(t, err)
+
+	var pods corev1.PodList
+	require.NoError(t, r.List(ctx, &pods, client.InNamespace("default"), client.MatchingLabels(provider.SelectorLabels(mcpProvider))))
+	assert.Len(t, pods.Items, 3)
+}
+
+// TestReconcilePodSet_ScalesDownRemovesExtraPods covers the scale-down
+// path: a Pod whose ordinal is no longer desired is deleted outright,
+// regardless of the rollout's maxUnavailable budget.
+func TestReconcilePodSet_ScalesDownRemovesExtraPods(t *testing.T) {
+	ctx := context.Background()
+	mcpProvider := podModeProvider(3)
+
+	existingPods, err := provider.BuildPodsForProvider(mcpProvider)
+	require.NoError(t, err)
+	objs := make([]client.Object, 0, len(existingPods)+1)
+	objs = append(objs, mcpProvider)
+	for _, pod := range existingPods {
+		objs = append(objs, pod)
+	}
+	r := newTestReconciler(t, objs...)
+
+	mcpProvider.Spec.Replicas = int32Ptr(1)
+	desiredPods, err := provider.BuildPodsForProvider(mcpProvider)
+	require.NoError(t, err)
+
+	_, err = r.reconcilePodSet(ctx, mcpProvider, desiredPods)
+	require.NoError(t, err)
+
+	var pods corev1.PodList
+	require.NoError(t, r.List(ctx, &pods, client.InNamespace("default"), client.MatchingLabels(provider.SelectorLabels(mcpProvider))))
+	assert.Len(t, pods.Items, 1)
+}
+
+func int32Ptr(v int32) *int32 { return &v }
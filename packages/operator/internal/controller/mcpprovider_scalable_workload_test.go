@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+	"github.com/mapyr/mcp-hangar/operator/pkg/provider"
+)
+
+// newTestScheme returns a Scheme with corev1, appsv1, and MCPProvider
+// registered. v1alpha1 has no generated AddToScheme (unlike v1beta1's
+// groupversion_info.go), so the MCP types are registered by hand here
+// rather than faked with a go.mod this repo snapshot doesn't have.
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	gv := schema.GroupVersion{Group: "mcp-hangar.io", Version: "v1alpha1"}
+	s.AddKnownTypes(gv, &mcpv1alpha1.MCPProvider{}, &mcpv1alpha1.MCPProviderList{})
+	metav1.AddToGroupVersion(s, gv)
+	return s
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) *MCPProviderReconciler {
+	t.Helper()
+	scheme := newTestScheme(t)
+	return &MCPProviderReconciler{
+		Client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&mcpv1alpha1.MCPProvider{}).
+			Build(),
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(32),
+		Config:   DefaultReconcilerConfig(),
+	}
+}
+
+func deploymentModeProvider(replicas int32, image string) *mcpv1alpha1.MCPProvider {
+	return &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+			UID:       "test-uid-123",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:         mcpv1alpha1.ProviderModeContainer,
+			Image:        image,
+			WorkloadKind: mcpv1alpha1.WorkloadKindDeployment,
+			Replicas:     &replicas,
+		},
+	}
+}
+
+// TestReconcileScalableWorkload_CreatesThenUpdatesDeployment exercises the
+// chunk1-5 fix: reconcileScalableWorkload must not just create the
+// Deployment once and leave it alone - a later call with a changed Spec
+// must update replicas and the pod template on the existing object.
+func TestReconcileScalableWorkload_CreatesThenUpdatesDeployment(t *testing.T) {
+	ctx := context.Background()
+	mcpProvider := deploymentModeProvider(2, "test-image:v1")
+	r := newTestReconciler(t, mcpProvider)
+
+	desired, err := provider.BuildWorkloadForProvider(mcpProvider)
+	require.NoError(t, err)
+
+	_, err = r.reconcileScalableWorkload(ctx, mcpProvider, desired)
+	require.NoError(t, err)
+
+	var deployment appsv1.Deployment
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(desired), &deployment))
+	require.NotNil(t, deployment.Spec.Replicas)
+	assert.Equal(t, int32(2), *deployment.Spec.Replicas)
+	assert.Equal(t, "test-image:v1", deployment.Spec.Template.Spec.Containers[0].Image)
+
+	// Spec changes (more replicas, new image) must reach the running
+	// Deployment on the next reconcile, not just at creation time.
+	newReplicas := int32(5)
+	mcpProvider.Spec.Replicas = &newReplicas
+	mcpProvider.Spec.Image = "test-image:v2"
+	desired, err = provider.BuildWorkloadForProvider(mcpProvider)
+	require.NoError(t, err)
+
+	_, err = r.reconcileScalableWorkload(ctx, mcpProvider, desired)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(desired), &deployment))
+	require.NotNil(t, deployment.Spec.Replicas)
+	assert.Equal(t, int32(5), *deployment.Spec.Replicas)
+	assert.Equal(t, "test-image:v2", deployment.Spec.Template.Spec.Containers[0].Image)
+}
+
+func TestReconcileScalableWorkload_NoopWhenUnchanged(t *testing.T) {
+	ctx := context.Background()
+	mcpProvider := deploymentModeProvider(2, "test-image:v1")
+	r := newTestReconciler(t, mcpProvider)
+
+	desired, err := provider.BuildWorkloadForProvider(mcpProvider)
+	require.NoError(t, err)
+	require.NoError(t, r.Create(ctx, desired))
+
+	var before appsv1.Deployment
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(desired), &before))
+
+	desired, err = provider.BuildWorkloadForProvider(mcpProvider)
+	require.NoError(t, err)
+	_, err = r.reconcileScalableWorkload(ctx, mcpProvider, desired)
+	require.NoError(t, err)
+
+	var after appsv1.Deployment
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(desired), &after))
+	assert.Equal(t, before.ResourceVersion, after.ResourceVersion, "unchanged desired spec should not trigger an Update")
+}
+
@@ -0,0 +1,161 @@
+// Package discovery also implements drift detection for DriftPolicy: given
+// a managed MCPProvider's live spec and the spec ProviderTemplate +
+// RelabelConfigs would currently produce for it, find which fieldpaths
+// differ and, for DriftPolicyReconcile, build a corrected spec that leaves
+// PreserveFields alone. There is no MCPDiscoverySource reconciler in this
+// tree yet to run this on a schedule, so as with the rest of this package
+// it's a self-contained algorithm a future discovery sync loop can call per
+// managed provider.
+package discovery
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+// DiffSpec compares live against desired (the spec ProviderTemplate +
+// RelabelConfigs would currently produce) and returns the sorted
+// fieldpaths, dot-separated and rooted at "spec", that differ - e.g.
+// ["spec.image", "spec.replicas"]. Fieldpaths covered by preserve (exact
+// match or an ancestor in preserve) are excluded, matching
+// MCPDiscoverySourceSpec.PreserveFields semantics.
+func DiffSpec(live, desired *mcpv1alpha1.MCPProviderSpec, preserve []string) []string {
+	preserved := make(map[string]bool, len(preserve))
+	for _, p := range preserve {
+		preserved[p] = true
+	}
+
+	var paths []string
+	diffValue("spec", reflect.ValueOf(live).Elem(), reflect.ValueOf(desired).Elem(), preserved, &paths)
+	sort.Strings(paths)
+	return paths
+}
+
+// ReconcileSpec returns a copy of desired with every fieldpath in preserve
+// (and its descendants) reset back to live's value, so DriftPolicyReconcile
+// can apply it without clobbering hand-edited settings.
+func ReconcileSpec(live, desired *mcpv1alpha1.MCPProviderSpec, preserve []string) *mcpv1alpha1.MCPProviderSpec {
+	if len(preserve) == 0 {
+		result := *desired
+		return &result
+	}
+
+	preserved := make(map[string]bool, len(preserve))
+	for _, p := range preserve {
+		preserved[p] = true
+	}
+
+	result := *desired
+	resultVal := reflect.ValueOf(&result).Elem()
+	restorePreserved("spec", resultVal, reflect.ValueOf(live).Elem(), preserved)
+	return &result
+}
+
+func isPreserved(path string, preserved map[string]bool) bool {
+	if preserved[path] {
+		return true
+	}
+	for p := range preserved {
+		if strings.HasPrefix(path, p+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// diffValue recursively compares a and b (assumed to have identical types),
+// appending every differing leaf fieldpath to *paths. Structs recurse
+// field-by-field; everything else (slices, maps, scalars, pointers to
+// non-structs) is compared with DeepEqual as a single leaf.
+func diffValue(path string, a, b reflect.Value, preserved map[string]bool, paths *[]string) {
+	if isPreserved(path, preserved) {
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Ptr:
+		if a.IsNil() != b.IsNil() {
+			*paths = append(*paths, path)
+			return
+		}
+		if a.IsNil() {
+			return
+		}
+		if a.Elem().Kind() == reflect.Struct {
+			diffValue(path, a.Elem(), b.Elem(), preserved, paths)
+			return
+		}
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			*paths = append(*paths, path)
+		}
+
+	case reflect.Struct:
+		t := a.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			name := jsonFieldName(field)
+			if name == "-" {
+				continue
+			}
+			diffValue(path+"."+name, a.Field(i), b.Field(i), preserved, paths)
+		}
+
+	default:
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			*paths = append(*paths, path)
+		}
+	}
+}
+
+// restorePreserved walks dst/src in lockstep, overwriting dst's field with
+// src's value wherever path is preserved, and otherwise recursing into
+// struct/pointer-to-struct fields so a preserved fieldpath nested under an
+// otherwise-desired parent still takes effect.
+func restorePreserved(path string, dst, src reflect.Value, preserved map[string]bool) {
+	if isPreserved(path, preserved) {
+		dst.Set(src)
+		return
+	}
+
+	switch dst.Kind() {
+	case reflect.Ptr:
+		if dst.IsNil() || src.IsNil() {
+			return
+		}
+		if dst.Elem().Kind() == reflect.Struct {
+			restorePreserved(path, dst.Elem(), src.Elem(), preserved)
+		}
+
+	case reflect.Struct:
+		t := dst.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			name := jsonFieldName(field)
+			if name == "-" {
+				continue
+			}
+			restorePreserved(path+"."+name, dst.Field(i), src.Field(i), preserved)
+		}
+	}
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	tag := field.Tag.Get("json")
+	if tag == "" {
+		return field.Name
+	}
+	name := strings.Split(tag, ",")[0]
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
@@ -0,0 +1,84 @@
+package discovery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+func TestDiffSpec_NoDifferenceReturnsEmpty(t *testing.T) {
+	replicas := int32(2)
+	live := &mcpv1alpha1.MCPProviderSpec{Mode: mcpv1alpha1.ProviderModeContainer, Image: "mcp/foo:v1", Replicas: &replicas}
+	desired := &mcpv1alpha1.MCPProviderSpec{Mode: mcpv1alpha1.ProviderModeContainer, Image: "mcp/foo:v1", Replicas: &replicas}
+
+	assert.Empty(t, DiffSpec(live, desired, nil))
+}
+
+func TestDiffSpec_ReportsChangedScalarField(t *testing.T) {
+	live := &mcpv1alpha1.MCPProviderSpec{Mode: mcpv1alpha1.ProviderModeContainer, Image: "mcp/foo:v1"}
+	desired := &mcpv1alpha1.MCPProviderSpec{Mode: mcpv1alpha1.ProviderModeContainer, Image: "mcp/foo:v2"}
+
+	assert.Equal(t, []string{"spec.image"}, DiffSpec(live, desired, nil))
+}
+
+func TestDiffSpec_ReportsChangedPointerField(t *testing.T) {
+	liveReplicas := int32(1)
+	desiredReplicas := int32(3)
+	live := &mcpv1alpha1.MCPProviderSpec{Replicas: &liveReplicas}
+	desired := &mcpv1alpha1.MCPProviderSpec{Replicas: &desiredReplicas}
+
+	assert.Equal(t, []string{"spec.replicas"}, DiffSpec(live, desired, nil))
+}
+
+func TestDiffSpec_NilVsSetPointerIsADiff(t *testing.T) {
+	desiredReplicas := int32(3)
+	live := &mcpv1alpha1.MCPProviderSpec{}
+	desired := &mcpv1alpha1.MCPProviderSpec{Replicas: &desiredReplicas}
+
+	assert.Equal(t, []string{"spec.replicas"}, DiffSpec(live, desired, nil))
+}
+
+func TestDiffSpec_PreserveFieldExcludesExactMatch(t *testing.T) {
+	live := &mcpv1alpha1.MCPProviderSpec{Image: "mcp/foo:v1"}
+	desired := &mcpv1alpha1.MCPProviderSpec{Image: "mcp/foo:v2"}
+
+	assert.Empty(t, DiffSpec(live, desired, []string{"spec.image"}))
+}
+
+func TestDiffSpec_ReportsMultipleFieldsSorted(t *testing.T) {
+	live := &mcpv1alpha1.MCPProviderSpec{Image: "mcp/foo:v1", WorkingDir: "/a"}
+	desired := &mcpv1alpha1.MCPProviderSpec{Image: "mcp/foo:v2", WorkingDir: "/b"}
+
+	assert.Equal(t, []string{"spec.image", "spec.workingDir"}, DiffSpec(live, desired, nil))
+}
+
+func TestReconcileSpec_NoPreserveReturnsDesired(t *testing.T) {
+	live := &mcpv1alpha1.MCPProviderSpec{Image: "mcp/foo:v1"}
+	desired := &mcpv1alpha1.MCPProviderSpec{Image: "mcp/foo:v2"}
+
+	result := ReconcileSpec(live, desired, nil)
+
+	assert.Equal(t, "mcp/foo:v2", result.Image)
+}
+
+func TestReconcileSpec_PreservedFieldKeepsLiveValue(t *testing.T) {
+	live := &mcpv1alpha1.MCPProviderSpec{Image: "mcp/foo:v1", WorkingDir: "/custom"}
+	desired := &mcpv1alpha1.MCPProviderSpec{Image: "mcp/foo:v2", WorkingDir: "/template-default"}
+
+	result := ReconcileSpec(live, desired, []string{"spec.workingDir"})
+
+	assert.Equal(t, "mcp/foo:v2", result.Image)
+	assert.Equal(t, "/custom", result.WorkingDir)
+}
+
+func TestReconcileSpec_ThenDiffSpecShowsNoDriftOnPreservedField(t *testing.T) {
+	live := &mcpv1alpha1.MCPProviderSpec{Image: "mcp/foo:v1", WorkingDir: "/custom"}
+	desired := &mcpv1alpha1.MCPProviderSpec{Image: "mcp/foo:v2", WorkingDir: "/template-default"}
+	preserve := []string{"spec.workingDir"}
+
+	result := ReconcileSpec(live, desired, preserve)
+
+	assert.Empty(t, DiffSpec(result, &mcpv1alpha1.MCPProviderSpec{Image: "mcp/foo:v2", WorkingDir: "/custom"}, preserve))
+}
@@ -0,0 +1,137 @@
+// Package discovery implements the pure endpoint-enumeration logic behind
+// ServiceDiscoveryConfig.EndpointsMode: given a Service's Endpoints or
+// EndpointSlices, resolve individual healthy backend addresses into
+// ResolvedEndpoints - one MCPProvider per healthy replica - instead of the
+// one-per-Service behavior EndpointsModeService keeps, mirroring how
+// Prometheus's Kubernetes service discovery exposes "endpoints"/
+// "endpointslices" roles alongside "service". There is no
+// MCPDiscoverySource reconciler in this tree yet to call into it, so this
+// package is a self-contained algorithm a future discovery sync loop can
+// call per Service.
+package discovery
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+// ResolvedEndpoint is one backend address enumerated from a Service's
+// Endpoints or EndpointSlices, ready to become an MCPProvider.
+type ResolvedEndpoint struct {
+	// Source identifies the Endpoints/EndpointSlice object and address
+	// index this came from, for DiscoveredProvider.Source.
+	Source string
+
+	Address string
+	Port    int32
+
+	// Topology carries zone/node placement, when known.
+	Topology *mcpv1alpha1.EndpointTopology
+}
+
+// ResolveFromEndpoints enumerates addresses from a corev1.Endpoints
+// object's subsets matching portName, one ResolvedEndpoint per address.
+// corev1.Endpoints carries no per-address readiness beyond the
+// Addresses/NotReadyAddresses split and no topology, so only Addresses are
+// resolved and Topology is always nil - use ResolveFromEndpointSlices for
+// zone/node metadata and terminating-aware health gating.
+func ResolveFromEndpoints(endpoints *corev1.Endpoints, portName string) []ResolvedEndpoint {
+	var out []ResolvedEndpoint
+	for _, subset := range endpoints.Subsets {
+		port, ok := findEndpointsPort(subset.Ports, portName)
+		if !ok {
+			continue
+		}
+		for i, addr := range subset.Addresses {
+			out = append(out, ResolvedEndpoint{
+				Source:  fmt.Sprintf("%s[%d]", endpoints.Name, i),
+				Address: addr.IP,
+				Port:    port,
+			})
+		}
+	}
+	return out
+}
+
+func findEndpointsPort(ports []corev1.EndpointPort, name string) (int32, bool) {
+	for _, p := range ports {
+		if p.Name == name {
+			return p.Port, true
+		}
+	}
+	return 0, false
+}
+
+// ResolveFromEndpointSlices enumerates addresses from a Service's
+// EndpointSlices matching portName, excluding any endpoint that isn't
+// healthy per isEndpointHealthy, and attaching zone/node topology per
+// address when the slice reports it.
+func ResolveFromEndpointSlices(slices []discoveryv1.EndpointSlice, portName string) []ResolvedEndpoint {
+	var out []ResolvedEndpoint
+	for _, slice := range slices {
+		port, ok := findSlicePort(slice.Ports, portName)
+		if !ok {
+			continue
+		}
+		for i, ep := range slice.Endpoints {
+			if !isEndpointHealthy(ep.Conditions) {
+				continue
+			}
+			for _, addr := range ep.Addresses {
+				out = append(out, ResolvedEndpoint{
+					Source:   fmt.Sprintf("%s[%d]", slice.Name, i),
+					Address:  addr,
+					Port:     port,
+					Topology: endpointTopology(ep),
+				})
+			}
+		}
+	}
+	return out
+}
+
+func findSlicePort(ports []discoveryv1.EndpointPort, name string) (int32, bool) {
+	for _, p := range ports {
+		if p.Name != nil && *p.Name == name && p.Port != nil {
+			return *p.Port, true
+		}
+	}
+	return 0, false
+}
+
+// endpointTopology builds an EndpointTopology from ep's Zone/NodeName,
+// returning nil when the slice reports neither so DiscoveredProvider.Topology
+// stays unset rather than an all-empty struct.
+func endpointTopology(ep discoveryv1.Endpoint) *mcpv1alpha1.EndpointTopology {
+	if ep.Zone == nil && ep.NodeName == nil {
+		return nil
+	}
+	t := &mcpv1alpha1.EndpointTopology{}
+	if ep.Zone != nil {
+		t.Zone = *ep.Zone
+	}
+	if ep.NodeName != nil {
+		t.NodeName = *ep.NodeName
+	}
+	return t
+}
+
+// isEndpointHealthy applies the ready/serving/terminating gating
+// EndpointsModeEndpointSlices honors. An endpoint is routable only while
+// Ready (the API defaults an unset Ready to true for slices created before
+// this field existed) and not Terminating - MCP sessions aren't safe to
+// route to a draining Pod mid-shutdown, so unlike kube-proxy's connection
+// draining this excludes Serving-but-Terminating endpoints too.
+func isEndpointHealthy(cond discoveryv1.EndpointConditions) bool {
+	if cond.Terminating != nil && *cond.Terminating {
+		return false
+	}
+	if cond.Ready != nil {
+		return *cond.Ready
+	}
+	return true
+}
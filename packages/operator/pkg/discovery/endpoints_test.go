@@ -0,0 +1,127 @@
+package discovery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestResolveFromEndpoints_OneResultPerAddress(t *testing.T) {
+	endpoints := &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-svc"},
+		Subsets: []corev1.EndpointSubset{{
+			Ports:     []corev1.EndpointPort{{Name: "mcp", Port: 8080}},
+			Addresses: []corev1.EndpointAddress{{IP: "10.0.0.1"}, {IP: "10.0.0.2"}},
+		}},
+	}
+
+	resolved := ResolveFromEndpoints(endpoints, "mcp")
+
+	assert.Len(t, resolved, 2)
+	assert.Equal(t, "my-svc[0]", resolved[0].Source)
+	assert.Equal(t, "10.0.0.1", resolved[0].Address)
+	assert.Equal(t, int32(8080), resolved[0].Port)
+	assert.Nil(t, resolved[0].Topology)
+}
+
+func TestResolveFromEndpoints_SkipsSubsetsMissingPortName(t *testing.T) {
+	endpoints := &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-svc"},
+		Subsets: []corev1.EndpointSubset{{
+			Ports:     []corev1.EndpointPort{{Name: "other", Port: 9090}},
+			Addresses: []corev1.EndpointAddress{{IP: "10.0.0.1"}},
+		}},
+	}
+
+	assert.Empty(t, ResolveFromEndpoints(endpoints, "mcp"))
+}
+
+func TestResolveFromEndpointSlices_FiltersUnhealthyEndpoints(t *testing.T) {
+	portName := "mcp"
+	port := int32(8080)
+	ready, notReady, terminating := true, false, true
+
+	slices := []discoveryv1.EndpointSlice{{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-svc-abcde"},
+		Ports:      []discoveryv1.EndpointPort{{Name: &portName, Port: &port}},
+		Endpoints: []discoveryv1.Endpoint{
+			{Addresses: []string{"10.0.0.1"}, Conditions: discoveryv1.EndpointConditions{Ready: &ready}},
+			{Addresses: []string{"10.0.0.2"}, Conditions: discoveryv1.EndpointConditions{Ready: &notReady}},
+			{Addresses: []string{"10.0.0.3"}, Conditions: discoveryv1.EndpointConditions{Ready: &ready, Terminating: &terminating}},
+			{Addresses: []string{"10.0.0.4"}},
+		},
+	}}
+
+	resolved := ResolveFromEndpointSlices(slices, "mcp")
+
+	var addrs []string
+	for _, r := range resolved {
+		addrs = append(addrs, r.Address)
+	}
+	assert.ElementsMatch(t, []string{"10.0.0.1", "10.0.0.4"}, addrs)
+}
+
+func TestResolveFromEndpointSlices_AttachesTopology(t *testing.T) {
+	portName := "mcp"
+	port := int32(8080)
+	ready := true
+	zone := "us-east-1a"
+	node := "node-1"
+
+	slices := []discoveryv1.EndpointSlice{{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-svc-abcde"},
+		Ports:      []discoveryv1.EndpointPort{{Name: &portName, Port: &port}},
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses:  []string{"10.0.0.1"},
+				Conditions: discoveryv1.EndpointConditions{Ready: &ready},
+				Zone:       &zone,
+				NodeName:   &node,
+			},
+		},
+	}}
+
+	resolved := ResolveFromEndpointSlices(slices, "mcp")
+
+	assert.Len(t, resolved, 1)
+	assert.Equal(t, "my-svc-abcde[0]", resolved[0].Source)
+	assert.Equal(t, "us-east-1a", resolved[0].Topology.Zone)
+	assert.Equal(t, "node-1", resolved[0].Topology.NodeName)
+}
+
+func TestResolveFromEndpointSlices_NoTopologyWhenUnreported(t *testing.T) {
+	portName := "mcp"
+	port := int32(8080)
+	ready := true
+
+	slices := []discoveryv1.EndpointSlice{{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-svc-abcde"},
+		Ports:      []discoveryv1.EndpointPort{{Name: &portName, Port: &port}},
+		Endpoints: []discoveryv1.Endpoint{
+			{Addresses: []string{"10.0.0.1"}, Conditions: discoveryv1.EndpointConditions{Ready: &ready}},
+		},
+	}}
+
+	resolved := ResolveFromEndpointSlices(slices, "mcp")
+
+	assert.Nil(t, resolved[0].Topology)
+}
+
+func TestResolveFromEndpointSlices_SkipsSlicesMissingPortName(t *testing.T) {
+	otherPortName := "other"
+	port := int32(9090)
+	ready := true
+
+	slices := []discoveryv1.EndpointSlice{{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-svc-abcde"},
+		Ports:      []discoveryv1.EndpointPort{{Name: &otherPortName, Port: &port}},
+		Endpoints: []discoveryv1.Endpoint{
+			{Addresses: []string{"10.0.0.1"}, Conditions: discoveryv1.EndpointConditions{Ready: &ready}},
+		},
+	}}
+
+	assert.Empty(t, ResolveFromEndpointSlices(slices, "mcp"))
+}
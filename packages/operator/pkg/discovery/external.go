@@ -0,0 +1,141 @@
+package discovery
+
+import (
+	"strings"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+// ResolvedExternalEndpoint is one backend address enumerated from a
+// non-Kubernetes ServiceDiscoveryConfig.Backend, ready to become a
+// DiscoveredProvider the same way ResolvedEndpoint is for the Kubernetes
+// backend. As with ResolveFromEndpoints/ResolveFromEndpointSlices, these
+// functions translate records the caller has already fetched from the
+// backend (a Consul catalog response, a resolved SRV/A/AAAA lookup, a
+// browsed mDNS service list) - there is no MCPDiscoverySource reconciler in
+// this tree yet to own the network calls that produce those records.
+type ResolvedExternalEndpoint struct {
+	// Source identifies the backend record this came from, used as
+	// DiscoveredProvider.Source.
+	Source string
+
+	Address string
+	Port    int32
+}
+
+// ConsulCatalogEntry is one entry from a Consul catalog/health query,
+// carrying just the fields ResolveFromConsul needs.
+type ConsulCatalogEntry struct {
+	Node        string
+	ServiceID   string
+	Address     string
+	ServicePort int32
+	ServiceTags []string
+}
+
+// ResolveFromConsul translates Consul catalog entries into
+// ResolvedExternalEndpoints, keeping only entries carrying every tag in
+// cfg.Tags. DiscoveredProvider.Source becomes "<node>/<service ID>" so two
+// Consul services registered on the same node don't collide.
+func ResolveFromConsul(entries []ConsulCatalogEntry, cfg *mcpv1alpha1.ConsulDiscoveryConfig) []ResolvedExternalEndpoint {
+	var out []ResolvedExternalEndpoint
+	for _, e := range entries {
+		if !hasAllTags(e.ServiceTags, cfg.Tags) {
+			continue
+		}
+		out = append(out, ResolvedExternalEndpoint{
+			Source:  e.Node + "/" + e.ServiceID,
+			Address: e.Address,
+			Port:    e.ServicePort,
+		})
+	}
+	return out
+}
+
+func hasAllTags(have, want []string) bool {
+	for _, w := range want {
+		found := false
+		for _, h := range have {
+			if h == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// DNSSRVRecord is the subset of a resolved net.SRV record ResolveFromDNSSRV
+// needs, kept independent of the net package so callers can pass results
+// from any resolver.
+type DNSSRVRecord struct {
+	Target string
+	Port   uint16
+}
+
+// ResolveFromDNSSRV translates resolved SRV records into
+// ResolvedExternalEndpoints. DiscoveredProvider.Source is the record's
+// target hostname with any trailing root dot trimmed.
+func ResolveFromDNSSRV(records []DNSSRVRecord) []ResolvedExternalEndpoint {
+	out := make([]ResolvedExternalEndpoint, 0, len(records))
+	for _, r := range records {
+		target := strings.TrimSuffix(r.Target, ".")
+		out = append(out, ResolvedExternalEndpoint{
+			Source:  target,
+			Address: target,
+			Port:    int32(r.Port),
+		})
+	}
+	return out
+}
+
+// ResolveFromDNSHostFallback translates an A/AAAA lookup of recordName into
+// ResolvedExternalEndpoints using port, for DNSDiscoveryConfig's
+// FallbackToHostRecords behavior when recordName has no SRV records.
+// DiscoveredProvider.Source is "<recordName>/<address>" since a bare A/AAAA
+// lookup can return multiple addresses for one name.
+func ResolveFromDNSHostFallback(recordName string, addrs []string, port int32) []ResolvedExternalEndpoint {
+	out := make([]ResolvedExternalEndpoint, 0, len(addrs))
+	for _, addr := range addrs {
+		out = append(out, ResolvedExternalEndpoint{
+			Source:  recordName + "/" + addr,
+			Address: addr,
+			Port:    port,
+		})
+	}
+	return out
+}
+
+// MDNSServiceEntry is one service instance returned by browsing an mDNS
+// service type/domain, carrying just the fields ResolveFromMDNS needs.
+type MDNSServiceEntry struct {
+	// Instance is the mDNS instance name, e.g. "provider-a._mcp._tcp.local.".
+	Instance string
+	// Host is the target hostname, used when Address isn't reported.
+	Host string
+	// Address is the resolved IP, when the browse included it.
+	Address string
+	Port    int32
+}
+
+// ResolveFromMDNS translates browsed mDNS service entries into
+// ResolvedExternalEndpoints. DiscoveredProvider.Source is the mDNS instance
+// name.
+func ResolveFromMDNS(entries []MDNSServiceEntry) []ResolvedExternalEndpoint {
+	out := make([]ResolvedExternalEndpoint, 0, len(entries))
+	for _, e := range entries {
+		addr := e.Address
+		if addr == "" {
+			addr = e.Host
+		}
+		out = append(out, ResolvedExternalEndpoint{
+			Source:  e.Instance,
+			Address: addr,
+			Port:    e.Port,
+		})
+	}
+	return out
+}
@@ -0,0 +1,72 @@
+package discovery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+func TestResolveFromConsul_FiltersByRequiredTags(t *testing.T) {
+	entries := []ConsulCatalogEntry{
+		{Node: "node-1", ServiceID: "mcp-a", Address: "10.0.0.1", ServicePort: 8080, ServiceTags: []string{"prod", "mcp"}},
+		{Node: "node-2", ServiceID: "mcp-b", Address: "10.0.0.2", ServicePort: 8080, ServiceTags: []string{"staging"}},
+	}
+	cfg := &mcpv1alpha1.ConsulDiscoveryConfig{Tags: []string{"prod"}}
+
+	resolved := ResolveFromConsul(entries, cfg)
+
+	assert.Len(t, resolved, 1)
+	assert.Equal(t, "node-1/mcp-a", resolved[0].Source)
+	assert.Equal(t, "10.0.0.1", resolved[0].Address)
+	assert.Equal(t, int32(8080), resolved[0].Port)
+}
+
+func TestResolveFromConsul_NoTagsKeepsEverything(t *testing.T) {
+	entries := []ConsulCatalogEntry{
+		{Node: "node-1", ServiceID: "mcp-a", Address: "10.0.0.1", ServicePort: 8080},
+		{Node: "node-2", ServiceID: "mcp-b", Address: "10.0.0.2", ServicePort: 8080},
+	}
+
+	resolved := ResolveFromConsul(entries, &mcpv1alpha1.ConsulDiscoveryConfig{})
+
+	assert.Len(t, resolved, 2)
+}
+
+func TestResolveFromDNSSRV_TrimsTrailingDot(t *testing.T) {
+	resolved := ResolveFromDNSSRV([]DNSSRVRecord{
+		{Target: "provider-a.example.com.", Port: 8080},
+	})
+
+	assert.Len(t, resolved, 1)
+	assert.Equal(t, "provider-a.example.com", resolved[0].Source)
+	assert.Equal(t, "provider-a.example.com", resolved[0].Address)
+	assert.Equal(t, int32(8080), resolved[0].Port)
+}
+
+func TestResolveFromDNSHostFallback_OneResultPerAddress(t *testing.T) {
+	resolved := ResolveFromDNSHostFallback("providers.example.com", []string{"10.0.0.1", "10.0.0.2"}, 8080)
+
+	assert.Len(t, resolved, 2)
+	assert.Equal(t, "providers.example.com/10.0.0.1", resolved[0].Source)
+	assert.Equal(t, int32(8080), resolved[0].Port)
+}
+
+func TestResolveFromMDNS_FallsBackToHostWhenAddressUnset(t *testing.T) {
+	resolved := ResolveFromMDNS([]MDNSServiceEntry{
+		{Instance: "provider-a._mcp._tcp.local.", Host: "provider-a.local.", Port: 8080},
+	})
+
+	assert.Len(t, resolved, 1)
+	assert.Equal(t, "provider-a._mcp._tcp.local.", resolved[0].Source)
+	assert.Equal(t, "provider-a.local.", resolved[0].Address)
+}
+
+func TestResolveFromMDNS_PrefersResolvedAddress(t *testing.T) {
+	resolved := ResolveFromMDNS([]MDNSServiceEntry{
+		{Instance: "provider-a._mcp._tcp.local.", Host: "provider-a.local.", Address: "10.0.0.5", Port: 8080},
+	})
+
+	assert.Equal(t, "10.0.0.5", resolved[0].Address)
+}
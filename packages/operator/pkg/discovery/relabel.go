@@ -0,0 +1,203 @@
+// relabel.go implements the RelabelConfigs pipeline: compiling and running
+// Prometheus-style relabel rules against labels harvested from a discovery
+// candidate. As with the rest of this package, there is no
+// MCPDiscoverySource reconciler in this tree yet to harvest those labels
+// and call Pipeline.Run per candidate.
+
+package discovery
+
+import (
+	"crypto/md5" //nolint:gosec // content-addressing for hashmod bucketing, not security-sensitive
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+// Pipeline is a compiled, ready-to-run form of a []RelabelConfig: each
+// rule's Regex is compiled once so repeated evaluation against every
+// discovery candidate doesn't recompile it per call.
+type Pipeline struct {
+	rules []compiledRule
+}
+
+type compiledRule struct {
+	cfg   mcpv1alpha1.RelabelConfig
+	regex *regexp.Regexp
+}
+
+// NewPipeline compiles cfgs into a Pipeline, or returns an error naming the
+// first rule whose Regex fails to compile.
+func NewPipeline(cfgs []mcpv1alpha1.RelabelConfig) (*Pipeline, error) {
+	rules := make([]compiledRule, 0, len(cfgs))
+	for i, cfg := range cfgs {
+		pattern := cfg.Regex
+		if pattern == "" {
+			pattern = "(.*)"
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("relabel rule %d: compiling regex %q: %w", i, pattern, err)
+		}
+		rules = append(rules, compiledRule{cfg: cfg, regex: re})
+	}
+	return &Pipeline{rules: rules}, nil
+}
+
+// Apply runs the pipeline over labels, returning the resulting label set and
+// whether the candidate should be kept. A keep/drop rule that rejects the
+// candidate short-circuits the remaining rules, mirroring Prometheus's
+// relabel_configs evaluation order.
+func (p *Pipeline) Apply(labels map[string]string) (map[string]string, bool) {
+	out := make(map[string]string, len(labels))
+	for k, v := range labels {
+		out[k] = v
+	}
+	for _, rule := range p.rules {
+		keep := rule.apply(out)
+		if !keep {
+			return out, false
+		}
+	}
+	return out, true
+}
+
+func (r compiledRule) apply(labels map[string]string) bool {
+	sep := r.cfg.Separator
+	if sep == "" {
+		sep = ";"
+	}
+
+	switch r.cfg.Action {
+	case mcpv1alpha1.RelabelLabelMap:
+		for name, v := range labels {
+			if r.regex.MatchString(name) {
+				labels[r.regex.ReplaceAllString(name, r.cfg.Replacement)] = v
+			}
+		}
+		return true
+
+	case mcpv1alpha1.RelabelLabelDrop:
+		for name := range labels {
+			if r.regex.MatchString(name) {
+				delete(labels, name)
+			}
+		}
+		return true
+
+	case mcpv1alpha1.RelabelLabelKeep:
+		for name := range labels {
+			if !r.regex.MatchString(name) {
+				delete(labels, name)
+			}
+		}
+		return true
+	}
+
+	joined := r.joinSourceLabels(labels, sep)
+
+	switch r.cfg.Action {
+	case mcpv1alpha1.RelabelKeep:
+		return r.regex.MatchString(joined)
+
+	case mcpv1alpha1.RelabelDrop:
+		return !r.regex.MatchString(joined)
+
+	case mcpv1alpha1.RelabelHashmod:
+		if r.cfg.Modulus == 0 || r.cfg.TargetLabel == "" {
+			return true
+		}
+		sum := md5.Sum([]byte(joined)) //nolint:gosec // bucketing only
+		bucket := binary.BigEndian.Uint64(sum[8:]) % r.cfg.Modulus
+		labels[r.cfg.TargetLabel] = fmt.Sprintf("%d", bucket)
+		return true
+
+	case mcpv1alpha1.RelabelLowercase:
+		if r.cfg.TargetLabel != "" {
+			labels[r.cfg.TargetLabel] = strings.ToLower(joined)
+		}
+		return true
+
+	case mcpv1alpha1.RelabelUppercase:
+		if r.cfg.TargetLabel != "" {
+			labels[r.cfg.TargetLabel] = strings.ToUpper(joined)
+		}
+		return true
+
+	case mcpv1alpha1.RelabelReplace, "":
+		if !r.regex.MatchString(joined) || r.cfg.TargetLabel == "" {
+			return true
+		}
+		replacement := r.cfg.Replacement
+		if replacement == "" {
+			replacement = "$1"
+		}
+		labels[r.cfg.TargetLabel] = string(r.regex.ReplaceAll([]byte(joined), []byte(replacement)))
+		return true
+
+	default:
+		return true
+	}
+}
+
+func (r compiledRule) joinSourceLabels(labels map[string]string, sep string) string {
+	if len(r.cfg.SourceLabels) == 0 {
+		return ""
+	}
+	values := make([]string, len(r.cfg.SourceLabels))
+	for i, name := range r.cfg.SourceLabels {
+		values[i] = labels[name]
+	}
+	return strings.Join(values, sep)
+}
+
+// PipelineCache compiles and caches a Pipeline per owning MCPDiscoverySource
+// generation, so repeated evaluation across many discovered candidates in
+// the same sync doesn't recompile every rule's regex each time. Entries for
+// stale generations are evicted on the next Get for that source, keeping
+// the cache bounded to one entry per source.
+type PipelineCache struct {
+	mu      sync.Mutex
+	entries map[string]cachedPipeline
+}
+
+type cachedPipeline struct {
+	generation int64
+	pipeline   *Pipeline
+}
+
+// NewPipelineCache returns an empty PipelineCache.
+func NewPipelineCache() *PipelineCache {
+	return &PipelineCache{entries: make(map[string]cachedPipeline)}
+}
+
+// Get returns the compiled Pipeline for sourceKey (typically
+// "<namespace>/<name>" of the owning MCPDiscoverySource) at generation,
+// compiling and caching it if absent or if generation has advanced past
+// what's cached.
+func (c *PipelineCache) Get(sourceKey string, generation int64, cfgs []mcpv1alpha1.RelabelConfig) (*Pipeline, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := c.entries[sourceKey]; ok && entry.generation == generation {
+		return entry.pipeline, nil
+	}
+
+	pipeline, err := NewPipeline(cfgs)
+	if err != nil {
+		return nil, err
+	}
+	c.entries[sourceKey] = cachedPipeline{generation: generation, pipeline: pipeline}
+	return pipeline, nil
+}
+
+// Evict removes sourceKey's cached Pipeline, e.g. when its owning
+// MCPDiscoverySource is deleted.
+func (c *PipelineCache) Evict(sourceKey string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, sourceKey)
+}
@@ -0,0 +1,188 @@
+package discovery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+func TestPipeline_ReplaceSubstitutesCaptureGroup(t *testing.T) {
+	p, err := NewPipeline([]mcpv1alpha1.RelabelConfig{{
+		SourceLabels: []string{"__meta_namespace"},
+		Regex:        "(.+)",
+		TargetLabel:  "namespace",
+		Replacement:  "$1",
+		Action:       mcpv1alpha1.RelabelReplace,
+	}})
+	require.NoError(t, err)
+
+	out, keep := p.Apply(map[string]string{"__meta_namespace": "prod"})
+
+	assert.True(t, keep)
+	assert.Equal(t, "prod", out["namespace"])
+}
+
+func TestPipeline_KeepDropsUnmatchedCandidate(t *testing.T) {
+	p, err := NewPipeline([]mcpv1alpha1.RelabelConfig{{
+		SourceLabels: []string{"env"},
+		Regex:        "prod",
+		Action:       mcpv1alpha1.RelabelKeep,
+	}})
+	require.NoError(t, err)
+
+	_, keep := p.Apply(map[string]string{"env": "staging"})
+
+	assert.False(t, keep)
+}
+
+func TestPipeline_DropRejectsMatchedCandidate(t *testing.T) {
+	p, err := NewPipeline([]mcpv1alpha1.RelabelConfig{{
+		SourceLabels: []string{"env"},
+		Regex:        "staging",
+		Action:       mcpv1alpha1.RelabelDrop,
+	}})
+	require.NoError(t, err)
+
+	_, keep := p.Apply(map[string]string{"env": "staging"})
+
+	assert.False(t, keep)
+}
+
+func TestPipeline_DropKeepsCandidatesThatDontMatch(t *testing.T) {
+	p, err := NewPipeline([]mcpv1alpha1.RelabelConfig{{
+		SourceLabels: []string{"env"},
+		Regex:        "staging",
+		Action:       mcpv1alpha1.RelabelDrop,
+	}})
+	require.NoError(t, err)
+
+	_, keep := p.Apply(map[string]string{"env": "prod"})
+
+	assert.True(t, keep)
+}
+
+func TestPipeline_HashmodIsDeterministicAndBounded(t *testing.T) {
+	p, err := NewPipeline([]mcpv1alpha1.RelabelConfig{{
+		SourceLabels: []string{"pod"},
+		Modulus:      4,
+		TargetLabel:  "shard",
+		Action:       mcpv1alpha1.RelabelHashmod,
+	}})
+	require.NoError(t, err)
+
+	out1, _ := p.Apply(map[string]string{"pod": "provider-a"})
+	out2, _ := p.Apply(map[string]string{"pod": "provider-a"})
+
+	assert.Equal(t, out1["shard"], out2["shard"])
+	assert.Contains(t, []string{"0", "1", "2", "3"}, out1["shard"])
+}
+
+func TestPipeline_LabelMapCopiesMatchingLabelsUnderNewName(t *testing.T) {
+	p, err := NewPipeline([]mcpv1alpha1.RelabelConfig{{
+		Regex:       `__meta_annotation_(.+)`,
+		Replacement: "$1",
+		Action:      mcpv1alpha1.RelabelLabelMap,
+	}})
+	require.NoError(t, err)
+
+	out, keep := p.Apply(map[string]string{"__meta_annotation_tier": "gold"})
+
+	assert.True(t, keep)
+	assert.Equal(t, "gold", out["tier"])
+	assert.Equal(t, "gold", out["__meta_annotation_tier"])
+}
+
+func TestPipeline_LabelDropRemovesMatchingLabels(t *testing.T) {
+	p, err := NewPipeline([]mcpv1alpha1.RelabelConfig{{
+		Regex:  "^__meta_.*",
+		Action: mcpv1alpha1.RelabelLabelDrop,
+	}})
+	require.NoError(t, err)
+
+	out, _ := p.Apply(map[string]string{"__meta_namespace": "prod", "tier": "gold"})
+
+	assert.NotContains(t, out, "__meta_namespace")
+	assert.Equal(t, "gold", out["tier"])
+}
+
+func TestPipeline_LabelKeepRemovesNonMatchingLabels(t *testing.T) {
+	p, err := NewPipeline([]mcpv1alpha1.RelabelConfig{{
+		Regex:  "^__meta_.*",
+		Action: mcpv1alpha1.RelabelLabelKeep,
+	}})
+	require.NoError(t, err)
+
+	out, _ := p.Apply(map[string]string{"__meta_namespace": "prod", "tier": "gold"})
+
+	assert.Contains(t, out, "__meta_namespace")
+	assert.NotContains(t, out, "tier")
+}
+
+func TestPipeline_LowercaseAndUppercase(t *testing.T) {
+	p, err := NewPipeline([]mcpv1alpha1.RelabelConfig{
+		{SourceLabels: []string{"tier"}, TargetLabel: "tier_lower", Action: mcpv1alpha1.RelabelLowercase},
+		{SourceLabels: []string{"tier"}, TargetLabel: "tier_upper", Action: mcpv1alpha1.RelabelUppercase},
+	})
+	require.NoError(t, err)
+
+	out, _ := p.Apply(map[string]string{"tier": "Gold"})
+
+	assert.Equal(t, "gold", out["tier_lower"])
+	assert.Equal(t, "GOLD", out["tier_upper"])
+}
+
+func TestPipeline_StopsAtFirstDroppingRule(t *testing.T) {
+	p, err := NewPipeline([]mcpv1alpha1.RelabelConfig{
+		{SourceLabels: []string{"env"}, Regex: "staging", Action: mcpv1alpha1.RelabelDrop},
+		{SourceLabels: []string{"env"}, TargetLabel: "untouched", Action: mcpv1alpha1.RelabelLowercase},
+	})
+	require.NoError(t, err)
+
+	out, keep := p.Apply(map[string]string{"env": "staging"})
+
+	assert.False(t, keep)
+	assert.NotContains(t, out, "untouched")
+}
+
+func TestNewPipeline_InvalidRegexReturnsError(t *testing.T) {
+	_, err := NewPipeline([]mcpv1alpha1.RelabelConfig{{Regex: "("}})
+
+	assert.Error(t, err)
+}
+
+func TestPipelineCache_ReusesCompiledPipelineForSameGeneration(t *testing.T) {
+	cache := NewPipelineCache()
+	cfgs := []mcpv1alpha1.RelabelConfig{{Regex: "(.*)"}}
+
+	p1, err := cache.Get("ns/src", 1, cfgs)
+	require.NoError(t, err)
+	p2, err := cache.Get("ns/src", 1, cfgs)
+	require.NoError(t, err)
+
+	assert.Same(t, p1, p2)
+}
+
+func TestPipelineCache_RecompilesOnGenerationChange(t *testing.T) {
+	cache := NewPipelineCache()
+
+	p1, err := cache.Get("ns/src", 1, []mcpv1alpha1.RelabelConfig{{Regex: "(.*)"}})
+	require.NoError(t, err)
+	p2, err := cache.Get("ns/src", 2, []mcpv1alpha1.RelabelConfig{{Regex: "(.+)"}})
+	require.NoError(t, err)
+
+	assert.NotSame(t, p1, p2)
+}
+
+func TestPipelineCache_EvictRemovesEntry(t *testing.T) {
+	cache := NewPipelineCache()
+	cfgs := []mcpv1alpha1.RelabelConfig{{Regex: "(.*)"}}
+
+	_, err := cache.Get("ns/src", 1, cfgs)
+	require.NoError(t, err)
+	cache.Evict("ns/src")
+
+	assert.Empty(t, cache.entries)
+}
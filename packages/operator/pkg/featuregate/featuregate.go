@@ -0,0 +1,250 @@
+// Package featuregate implements a minimal, OpenShift-FeatureGateAccess-style
+// gate for experimental ServiceDiscovery backends and EndpointsModes, so they
+// can ship in the same operator binary as stable ones without being on by
+// default.
+//
+// Each gate has a fixed maturity Level registered in code (see Registry).
+// Operators opt an Alpha gate in by naming it explicitly on --feature-gates;
+// Beta and GA gates are always on, since the flag can only enable gates and
+// has no syntax for turning one off. Requiring the caller to type the level (e.g.
+// "ConsulDiscovery=Alpha") rather than a bare name or bool is deliberate: it
+// makes the operator acknowledge the risk tier they're opting into, and it
+// fails loudly (ErrLevelMismatch) if the code's registered level and the
+// flag's claimed level disagree, which usually means the flag was copied
+// from stale docs after a promotion.
+//
+// There is no MCPDiscoverySource reconciler in this tree yet (see
+// pkg/discovery's package doc), so nothing currently calls
+// CheckServiceDiscovery from a reconcile loop. Gates, the --feature-gates
+// flag, the /featuregates debug endpoint, and the per-gate metric are wired
+// up regardless, since none of that needs a reconciler to exist - only the
+// "set ConditionFeatureDisabled and stop reconciling" half of this request
+// is left for whenever that reconciler lands.
+package featuregate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+// Level is the maturity/promotion level of a gate, borrowed from
+// Kubernetes' own Alpha/Beta/GA feature-gate vocabulary.
+type Level string
+
+const (
+	// Alpha gates are off unless named explicitly on --feature-gates.
+	Alpha Level = "Alpha"
+	// Beta gates are on by default; --feature-gates cannot disable them.
+	Beta Level = "Beta"
+	// GA gates are always on and can't be disabled.
+	GA Level = "GA"
+)
+
+// Gate is one named, independently togglable feature.
+type Gate struct {
+	// Name is the identifier used on --feature-gates, e.g. "ConsulDiscovery".
+	Name string
+	// Level is this gate's registered maturity. It is fixed in code; the
+	// --feature-gates flag can only acknowledge it, not change it.
+	Level Level
+}
+
+// Registry lists every gate this operator binary knows about. Adding a new
+// experimental DiscoveryBackend or EndpointsMode means adding an entry here.
+var Registry = []Gate{
+	{Name: "EndpointSlicesDiscovery", Level: Beta},
+	{Name: "ConsulDiscovery", Level: Alpha},
+	{Name: "DNSDiscovery", Level: Alpha},
+	{Name: "MDNSDiscovery", Level: Alpha},
+}
+
+func lookup(name string) (Gate, bool) {
+	for _, g := range Registry {
+		if g.Name == name {
+			return g, true
+		}
+	}
+	return Gate{}, false
+}
+
+// ErrUnknownGate is returned by ParseFlag for a name not in Registry.
+type ErrUnknownGate struct{ Name string }
+
+func (e ErrUnknownGate) Error() string {
+	return fmt.Sprintf("featuregate: unknown gate %q", e.Name)
+}
+
+// ErrLevelMismatch is returned by ParseFlag when the flag's claimed level
+// doesn't match the gate's registered level - most often a stale override
+// left over from before (or after) a promotion.
+type ErrLevelMismatch struct {
+	Name          string
+	Claimed, Want Level
+}
+
+func (e ErrLevelMismatch) Error() string {
+	return fmt.Sprintf("featuregate: %s is registered as %s, not %s", e.Name, e.Want, e.Claimed)
+}
+
+// Gates is a resolved, read-only view of which gates are enabled.
+type Gates struct {
+	enabled map[string]bool
+}
+
+// Enabled reports whether the named gate is on. An unknown name is always
+// reported disabled rather than panicking, since callers pass gate names
+// derived from spec fields that may predate a Registry entry.
+func (g *Gates) Enabled(name string) bool {
+	if g == nil {
+		return false
+	}
+	return g.enabled[name]
+}
+
+// ParseFlag parses a --feature-gates value of the form
+// "Name=Level,Name2=Level2" into a Gates set, validating each entry against
+// Registry. Gates at Beta or GA are enabled by default even if absent from
+// raw; Alpha gates are disabled unless named.
+func ParseFlag(raw string) (*Gates, error) {
+	enabled := make(map[string]bool, len(Registry))
+	for _, gate := range Registry {
+		enabled[gate.Name] = gate.Level != Alpha
+	}
+
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return &Gates{enabled: enabled}, nil
+	}
+
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("featuregate: malformed --feature-gates entry %q, want Name=Level", pair)
+		}
+		name, level := strings.TrimSpace(parts[0]), Level(strings.TrimSpace(parts[1]))
+
+		gate, ok := lookup(name)
+		if !ok {
+			return nil, ErrUnknownGate{Name: name}
+		}
+		if gate.Level != level {
+			return nil, ErrLevelMismatch{Name: name, Claimed: level, Want: gate.Level}
+		}
+		enabled[name] = true
+	}
+
+	return &Gates{enabled: enabled}, nil
+}
+
+// InitializeWithTimeout parses raw the same way ParseFlag does, but bounds
+// the work by ctx/timeout the way FeatureGateAccess.InitializeFeatureGates
+// bounds its wait for an observed FeatureGate API object. Resolving
+// --feature-gates is pure string parsing today and returns immediately, but
+// keeping the ctx-bound signature means a future version that has to wait on
+// a real source (a ConfigMap, an admin API) can be dropped in here without
+// changing every caller.
+func InitializeWithTimeout(ctx context.Context, raw string, timeout time.Duration) (*Gates, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	done := make(chan struct{})
+	var gates *Gates
+	var err error
+	go func() {
+		defer close(done)
+		gates, err = ParseFlag(raw)
+	}()
+
+	select {
+	case <-done:
+		return gates, err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("featuregate: initialization did not complete within %s: %w", timeout, ctx.Err())
+	}
+}
+
+// gateForServiceDiscovery returns the gate name that guards cfg's backend or
+// EndpointsMode, or "" if cfg needs no gate (the stable Kubernetes backend in
+// Service mode).
+func gateForServiceDiscovery(cfg *mcpv1alpha1.ServiceDiscoveryConfig) string {
+	if cfg == nil {
+		return ""
+	}
+	switch cfg.Backend {
+	case mcpv1alpha1.DiscoveryBackendConsul:
+		return "ConsulDiscovery"
+	case mcpv1alpha1.DiscoveryBackendDNS:
+		return "DNSDiscovery"
+	case mcpv1alpha1.DiscoveryBackendMDNS:
+		return "MDNSDiscovery"
+	}
+	if cfg.EndpointsMode == mcpv1alpha1.EndpointsModeEndpointSlices {
+		return "EndpointSlicesDiscovery"
+	}
+	return ""
+}
+
+// CheckServiceDiscovery reports whether gates permits cfg's backend and
+// EndpointsMode to run. allowed is true when cfg needs no gate, or the gate
+// it needs is enabled. When allowed is false, gateName and level identify
+// the gate a reconciler should cite in a Reason/Message pair on a
+// mcpv1alpha1.ConditionFeatureDisabled condition.
+func CheckServiceDiscovery(gates *Gates, cfg *mcpv1alpha1.ServiceDiscoveryConfig) (allowed bool, gateName string, level Level) {
+	name := gateForServiceDiscovery(cfg)
+	if name == "" {
+		return true, "", ""
+	}
+	gate, _ := lookup(name)
+	return gates.Enabled(name), name, gate.Level
+}
+
+// DisabledConditionMessage renders the Reason/Message pair a reconciler
+// should set on ConditionFeatureDisabled for a blocked gate.
+func DisabledConditionMessage(gateName string, level Level) (reason, message string) {
+	return "FeatureGateDisabled", fmt.Sprintf(
+		"gate %s is disabled; enable it with --feature-gates=%s=%s to use this discovery backend",
+		gateName, gateName, level,
+	)
+}
+
+// Snapshot is a point-in-time, sorted view of gate state, suitable for
+// marshaling onto the /featuregates debug endpoint.
+type Snapshot struct {
+	Name    string `json:"name"`
+	Level   Level  `json:"level"`
+	Enabled bool   `json:"enabled"`
+
+	// ConsumedByReconciler reports whether any reconciler in this binary
+	// actually calls CheckServiceDiscovery for this gate. It is hardcoded
+	// false today: there is no MCPDiscoverySource reconciler in this tree,
+	// so enabling a gate only flips Enabled here and in the
+	// FeatureGateEnabled metric - it does not yet unblock any discovery
+	// backend in a running operator. Flip this to a real per-gate lookup
+	// once that reconciler calls CheckServiceDiscovery.
+	ConsumedByReconciler bool `json:"consumedByReconciler"`
+}
+
+// Snapshot returns every Registry gate's current state, sorted by name for a
+// stable /featuregates response.
+func (g *Gates) Snapshot() []Snapshot {
+	out := make([]Snapshot, 0, len(Registry))
+	for _, gate := range Registry {
+		out = append(out, Snapshot{
+			Name:                 gate.Name,
+			Level:                gate.Level,
+			Enabled:              g.Enabled(gate.Name),
+			ConsumedByReconciler: false,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
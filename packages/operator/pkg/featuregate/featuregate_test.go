@@ -0,0 +1,113 @@
+package featuregate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+func TestParseFlag_BetaGatesEnabledByDefault(t *testing.T) {
+	gates, err := ParseFlag("")
+	require.NoError(t, err)
+
+	assert.True(t, gates.Enabled("EndpointSlicesDiscovery"))
+	assert.False(t, gates.Enabled("ConsulDiscovery"))
+}
+
+func TestParseFlag_ExplicitAlphaGateIsEnabled(t *testing.T) {
+	gates, err := ParseFlag("ConsulDiscovery=Alpha")
+	require.NoError(t, err)
+
+	assert.True(t, gates.Enabled("ConsulDiscovery"))
+	assert.False(t, gates.Enabled("DNSDiscovery"))
+}
+
+func TestParseFlag_MultipleEntries(t *testing.T) {
+	gates, err := ParseFlag("ConsulDiscovery=Alpha,DNSDiscovery=Alpha")
+	require.NoError(t, err)
+
+	assert.True(t, gates.Enabled("ConsulDiscovery"))
+	assert.True(t, gates.Enabled("DNSDiscovery"))
+	assert.False(t, gates.Enabled("MDNSDiscovery"))
+}
+
+func TestParseFlag_UnknownGateErrors(t *testing.T) {
+	_, err := ParseFlag("MadeUpDiscovery=Alpha")
+
+	require.Error(t, err)
+	assert.IsType(t, ErrUnknownGate{}, err)
+}
+
+func TestParseFlag_LevelMismatchErrors(t *testing.T) {
+	_, err := ParseFlag("ConsulDiscovery=Beta")
+
+	require.Error(t, err)
+	assert.IsType(t, ErrLevelMismatch{}, err)
+}
+
+func TestParseFlag_MalformedEntryErrors(t *testing.T) {
+	_, err := ParseFlag("ConsulDiscovery")
+
+	require.Error(t, err)
+}
+
+func TestInitializeWithTimeout_Succeeds(t *testing.T) {
+	gates, err := InitializeWithTimeout(context.Background(), "ConsulDiscovery=Alpha", time.Second)
+
+	require.NoError(t, err)
+	assert.True(t, gates.Enabled("ConsulDiscovery"))
+}
+
+func TestCheckServiceDiscovery_KubernetesServiceModeNeedsNoGate(t *testing.T) {
+	gates, err := ParseFlag("")
+	require.NoError(t, err)
+
+	allowed, name, _ := CheckServiceDiscovery(gates, &mcpv1alpha1.ServiceDiscoveryConfig{
+		Backend: mcpv1alpha1.DiscoveryBackendKubernetes,
+	})
+
+	assert.True(t, allowed)
+	assert.Empty(t, name)
+}
+
+func TestCheckServiceDiscovery_ConsulBlockedUntilAcknowledged(t *testing.T) {
+	gates, err := ParseFlag("")
+	require.NoError(t, err)
+
+	allowed, name, level := CheckServiceDiscovery(gates, &mcpv1alpha1.ServiceDiscoveryConfig{
+		Backend: mcpv1alpha1.DiscoveryBackendConsul,
+	})
+
+	assert.False(t, allowed)
+	assert.Equal(t, "ConsulDiscovery", name)
+	assert.Equal(t, Alpha, level)
+}
+
+func TestCheckServiceDiscovery_EndpointSlicesAllowedByDefault(t *testing.T) {
+	gates, err := ParseFlag("")
+	require.NoError(t, err)
+
+	allowed, _, _ := CheckServiceDiscovery(gates, &mcpv1alpha1.ServiceDiscoveryConfig{
+		Backend:       mcpv1alpha1.DiscoveryBackendKubernetes,
+		EndpointsMode: mcpv1alpha1.EndpointsModeEndpointSlices,
+	})
+
+	assert.True(t, allowed)
+}
+
+func TestGates_Snapshot_SortedByName(t *testing.T) {
+	gates, err := ParseFlag("")
+	require.NoError(t, err)
+
+	snapshot := gates.Snapshot()
+
+	require.Len(t, snapshot, len(Registry))
+	for i := 1; i < len(snapshot); i++ {
+		assert.Less(t, snapshot[i-1].Name, snapshot[i].Name)
+	}
+}
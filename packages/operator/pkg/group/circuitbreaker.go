@@ -0,0 +1,415 @@
+// Package group implements the circuit breaker and outlier detection
+// algorithm GroupCircuitBreakerConfig describes: a group-level breaker that
+// stops routing to any member while Open, plus per-member outlier ejection
+// so one bad member doesn't have to trip the whole group. There is no
+// MCPProviderGroup reconciler in this tree yet to call into it, so Tracker
+// is a self-contained, concurrency-safe piece of logic a future group
+// router/reconciler can call RecordResult and AllowRequest on per call.
+package group
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+const (
+	// DefaultFailureThreshold applies when CircuitBreaker.FailureThreshold
+	// is unset or non-positive.
+	DefaultFailureThreshold = int32(10)
+	// DefaultResetTimeout applies when CircuitBreaker.ResetTimeout is
+	// unset or fails to parse.
+	DefaultResetTimeout = time.Minute
+	// DefaultHalfOpenMaxProbes applies when CircuitBreaker.HalfOpenMaxProbes
+	// is unset or non-positive.
+	DefaultHalfOpenMaxProbes = int32(1)
+	// DefaultSuccessThreshold applies when CircuitBreaker.SuccessThreshold
+	// is unset or non-positive.
+	DefaultSuccessThreshold = int32(1)
+	// DefaultConsecutive5xxErrors applies when
+	// OutlierDetection.Consecutive5xxErrors is unset or non-positive.
+	DefaultConsecutive5xxErrors = int32(5)
+	// DefaultConsecutiveGatewayErrors applies when
+	// OutlierDetection.ConsecutiveGatewayErrors is unset or non-positive.
+	DefaultConsecutiveGatewayErrors = int32(5)
+	// DefaultSuccessRateStdevFactor applies when
+	// OutlierDetection.SuccessRateStdevFactor is unset or non-positive.
+	DefaultSuccessRateStdevFactor = int32(2)
+	// DefaultBaseEjectionTime applies when OutlierDetection.BaseEjectionTime
+	// is unset or fails to parse.
+	DefaultBaseEjectionTime = 30 * time.Second
+	// DefaultMaxEjectionPercent applies when
+	// OutlierDetection.MaxEjectionPercent is unset.
+	DefaultMaxEjectionPercent = int32(10)
+
+	// windowSize is how many recent calls each member's success-rate
+	// window remembers.
+	windowSize = 50
+	// minWindowSamples is how many calls a member needs in its window
+	// before its success rate counts toward the group mean/stdev - too
+	// few samples make an early failure or two look like an outlier.
+	minWindowSamples = 10
+)
+
+// EjectionReason names why RecordResult ejected a member, used as
+// metrics.ProviderEjectionsTotal's reason label.
+type EjectionReason string
+
+const (
+	EjectionConsecutive5xx        EjectionReason = "consecutive_5xx"
+	EjectionConsecutiveGatewayErr EjectionReason = "consecutive_gateway_error"
+	EjectionSuccessRateOutlier    EjectionReason = "success_rate_outlier"
+)
+
+// CallResult is what a group router reports to RecordResult after sending
+// one call to one member.
+type CallResult struct {
+	Success      bool
+	Is5xx        bool
+	IsGatewayErr bool
+}
+
+// Tracker holds circuit breaker and outlier detection state for every
+// group it's asked about, keyed by the group's UID so a recreated group
+// (new UID, same name) starts from a clean breaker. Safe for concurrent
+// use.
+type Tracker struct {
+	groups sync.Map // types.UID -> *groupState
+}
+
+// NewTracker returns an empty Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{}
+}
+
+type groupState struct {
+	mu                  sync.Mutex
+	state               mcpv1alpha1.GroupCircuitState
+	consecutiveFailures int32
+	openedAt            time.Time
+	halfOpenProbes      int32
+	halfOpenSuccesses   int32
+	reopenCount         int32
+	members             map[string]*memberState
+}
+
+type memberState struct {
+	window        [windowSize]bool
+	next          int
+	filled        int
+	consec5xx     int32
+	consecGateway int32
+	ejectedUntil  time.Time
+	ejectionCount int32
+}
+
+func (m *memberState) record(success bool) {
+	m.window[m.next] = success
+	m.next = (m.next + 1) % windowSize
+	if m.filled < windowSize {
+		m.filled++
+	}
+}
+
+func (m *memberState) successRate() (rate float64, ok bool) {
+	if m.filled < minWindowSamples {
+		return 0, false
+	}
+	successes := 0
+	for i := 0; i < m.filled; i++ {
+		if m.window[i] {
+			successes++
+		}
+	}
+	return float64(successes) / float64(m.filled), true
+}
+
+func (m *memberState) ejected(now time.Time) bool {
+	return now.Before(m.ejectedUntil)
+}
+
+func (t *Tracker) group(groupUID types.UID) *groupState {
+	v, _ := t.groups.LoadOrStore(groupUID, &groupState{
+		state:   mcpv1alpha1.GroupCircuitClosed,
+		members: make(map[string]*memberState),
+	})
+	return v.(*groupState)
+}
+
+// State returns a group's current circuit breaker state, Closed if it has
+// never recorded a call.
+func (t *Tracker) State(groupUID types.UID) mcpv1alpha1.GroupCircuitState {
+	g := t.group(groupUID)
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.state
+}
+
+// AllowRequest reports whether a call to member should be routed at all:
+// false if the group breaker is Open, or if member is currently ejected by
+// outlier detection. Callers should route HalfOpen probes one at a time up
+// to CircuitBreaker.HalfOpenMaxProbes themselves; AllowRequest doesn't
+// track in-flight probes since it has no way to learn when one finishes
+// short of the RecordResult call that already reports its outcome.
+func (t *Tracker) AllowRequest(groupUID types.UID, member string, now time.Time) bool {
+	g := t.group(groupUID)
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.state == mcpv1alpha1.GroupCircuitOpen {
+		return false
+	}
+	if m, ok := g.members[member]; ok && m.ejected(now) {
+		return false
+	}
+	return true
+}
+
+// RecordResultOutcome is what RecordResult returns: the group breaker's
+// resulting state, and, if member was just ejected by outlier detection,
+// the reason - so a caller can feed it straight to
+// metrics.ProviderEjectionsTotal and metrics.GroupCircuitTransitionsTotal.
+type RecordResultOutcome struct {
+	State          mcpv1alpha1.GroupCircuitState
+	PriorState     mcpv1alpha1.GroupCircuitState
+	Ejected        bool
+	EjectionReason EjectionReason
+}
+
+// RecordResult folds one call's result for member into the group's circuit
+// breaker and outlier detection state, ejecting member or transitioning
+// the group breaker as config requires.
+func (t *Tracker) RecordResult(groupUID types.UID, member string, result CallResult, config *mcpv1alpha1.GroupCircuitBreakerConfig, now time.Time) RecordResultOutcome {
+	g := t.group(groupUID)
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	m, ok := g.members[member]
+	if !ok {
+		m = &memberState{}
+		g.members[member] = m
+	}
+	m.record(result.Success)
+
+	if result.Success {
+		m.consec5xx, m.consecGateway = 0, 0
+		g.consecutiveFailures = 0
+	} else {
+		if result.Is5xx {
+			m.consec5xx++
+		}
+		if result.IsGatewayErr {
+			m.consecGateway++
+		}
+		g.consecutiveFailures++
+	}
+
+	ejected, reason := t.applyOutlierDetection(g, member, m, config, now)
+	priorState := g.state
+	t.advanceGroup(g, config, result.Success, now)
+
+	return RecordResultOutcome{
+		State:          g.state,
+		PriorState:     priorState,
+		Ejected:        ejected,
+		EjectionReason: reason,
+	}
+}
+
+// applyOutlierDetection ejects member if it just crossed a consecutive
+// 5xx/gateway-error threshold, or if its success rate has fallen too far
+// below the group's mean, bounded by MaxEjectionPercent.
+func (t *Tracker) applyOutlierDetection(g *groupState, member string, m *memberState, config *mcpv1alpha1.GroupCircuitBreakerConfig, now time.Time) (bool, EjectionReason) {
+	outlier := groupOutlierConfig(config)
+
+	if m.ejected(now) {
+		return false, ""
+	}
+
+	switch {
+	case m.consec5xx >= resolveConsecutive5xx(outlier):
+		return t.eject(g, member, m, outlier, EjectionConsecutive5xx, now), EjectionConsecutive5xx
+	case m.consecGateway >= resolveConsecutiveGateway(outlier):
+		return t.eject(g, member, m, outlier, EjectionConsecutiveGatewayErr, now), EjectionConsecutiveGatewayErr
+	default:
+		if rate, ok := m.successRate(); ok {
+			if mean, stdev, peers := groupSuccessRateStats(g, member); peers > 0 && stdev > 0 {
+				factor := float64(resolveStdevFactor(outlier))
+				if rate < mean-factor*stdev {
+					return t.eject(g, member, m, outlier, EjectionSuccessRateOutlier, now), EjectionSuccessRateOutlier
+				}
+			}
+		}
+	}
+	return false, ""
+}
+
+// eject ejects member unless MaxEjectionPercent of the group is already
+// ejected, reporting whether it actually did so.
+func (t *Tracker) eject(g *groupState, member string, m *memberState, outlier *mcpv1alpha1.OutlierDetectionConfig, reason EjectionReason, now time.Time) bool {
+	maxEjected := (len(g.members) * int(resolveMaxEjectionPercent(outlier))) / 100
+	ejectedCount := 0
+	for name, other := range g.members {
+		if name != member && other.ejected(now) {
+			ejectedCount++
+		}
+	}
+	if ejectedCount >= maxEjected {
+		return false
+	}
+
+	m.ejectionCount++
+	base := resolveBaseEjectionTime(outlier)
+	m.ejectedUntil = now.Add(base * time.Duration(m.ejectionCount))
+	return true
+}
+
+// groupSuccessRateStats returns the mean and population standard deviation
+// of every other member's success rate that has enough samples to count.
+func groupSuccessRateStats(g *groupState, exclude string) (mean, stdev float64, n int) {
+	var rates []float64
+	for name, m := range g.members {
+		if name == exclude {
+			continue
+		}
+		if rate, ok := m.successRate(); ok {
+			rates = append(rates, rate)
+		}
+	}
+	if len(rates) == 0 {
+		return 0, 0, 0
+	}
+	sum := 0.0
+	for _, r := range rates {
+		sum += r
+	}
+	mean = sum / float64(len(rates))
+
+	variance := 0.0
+	for _, r := range rates {
+		variance += (r - mean) * (r - mean)
+	}
+	variance /= float64(len(rates))
+
+	return mean, math.Sqrt(variance), len(rates)
+}
+
+// advanceGroup runs the group breaker's own Closed/Open/HalfOpen state
+// machine, independent of member-level outlier ejection above.
+func (t *Tracker) advanceGroup(g *groupState, config *mcpv1alpha1.GroupCircuitBreakerConfig, success bool, now time.Time) {
+	switch g.state {
+	case mcpv1alpha1.GroupCircuitClosed:
+		if g.consecutiveFailures >= resolveFailureThreshold(config) {
+			g.state = mcpv1alpha1.GroupCircuitOpen
+			g.openedAt = now
+		}
+	case mcpv1alpha1.GroupCircuitOpen:
+		backoff := resolveResetTimeout(config) * time.Duration(1<<minInt32(g.reopenCount, 6))
+		if now.Sub(g.openedAt) >= backoff {
+			g.state = mcpv1alpha1.GroupCircuitHalfOpen
+			g.halfOpenProbes, g.halfOpenSuccesses = 0, 0
+		}
+	case mcpv1alpha1.GroupCircuitHalfOpen:
+		g.halfOpenProbes++
+		if success {
+			g.halfOpenSuccesses++
+		}
+		switch {
+		case g.halfOpenSuccesses >= resolveSuccessThreshold(config):
+			g.state = mcpv1alpha1.GroupCircuitClosed
+			g.consecutiveFailures = 0
+			g.reopenCount = 0
+		case g.halfOpenProbes >= resolveHalfOpenMaxProbes(config):
+			g.state = mcpv1alpha1.GroupCircuitOpen
+			g.openedAt = now
+			g.reopenCount++
+		}
+	}
+}
+
+func minInt32(a, b int32) int32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func groupOutlierConfig(config *mcpv1alpha1.GroupCircuitBreakerConfig) *mcpv1alpha1.OutlierDetectionConfig {
+	if config == nil {
+		return nil
+	}
+	return config.OutlierDetection
+}
+
+func resolveFailureThreshold(config *mcpv1alpha1.GroupCircuitBreakerConfig) int32 {
+	if config == nil || config.FailureThreshold <= 0 {
+		return DefaultFailureThreshold
+	}
+	return config.FailureThreshold
+}
+
+func resolveResetTimeout(config *mcpv1alpha1.GroupCircuitBreakerConfig) time.Duration {
+	if config == nil || config.ResetTimeout == "" {
+		return DefaultResetTimeout
+	}
+	if d, err := time.ParseDuration(config.ResetTimeout); err == nil {
+		return d
+	}
+	return DefaultResetTimeout
+}
+
+func resolveHalfOpenMaxProbes(config *mcpv1alpha1.GroupCircuitBreakerConfig) int32 {
+	if config == nil || config.HalfOpenMaxProbes <= 0 {
+		return DefaultHalfOpenMaxProbes
+	}
+	return config.HalfOpenMaxProbes
+}
+
+func resolveSuccessThreshold(config *mcpv1alpha1.GroupCircuitBreakerConfig) int32 {
+	if config == nil || config.SuccessThreshold <= 0 {
+		return DefaultSuccessThreshold
+	}
+	return config.SuccessThreshold
+}
+
+func resolveConsecutive5xx(outlier *mcpv1alpha1.OutlierDetectionConfig) int32 {
+	if outlier == nil || outlier.Consecutive5xxErrors <= 0 {
+		return DefaultConsecutive5xxErrors
+	}
+	return outlier.Consecutive5xxErrors
+}
+
+func resolveConsecutiveGateway(outlier *mcpv1alpha1.OutlierDetectionConfig) int32 {
+	if outlier == nil || outlier.ConsecutiveGatewayErrors <= 0 {
+		return DefaultConsecutiveGatewayErrors
+	}
+	return outlier.ConsecutiveGatewayErrors
+}
+
+func resolveStdevFactor(outlier *mcpv1alpha1.OutlierDetectionConfig) int32 {
+	if outlier == nil || outlier.SuccessRateStdevFactor <= 0 {
+		return DefaultSuccessRateStdevFactor
+	}
+	return outlier.SuccessRateStdevFactor
+}
+
+func resolveBaseEjectionTime(outlier *mcpv1alpha1.OutlierDetectionConfig) time.Duration {
+	if outlier == nil || outlier.BaseEjectionTime == "" {
+		return DefaultBaseEjectionTime
+	}
+	if d, err := time.ParseDuration(outlier.BaseEjectionTime); err == nil {
+		return d
+	}
+	return DefaultBaseEjectionTime
+}
+
+func resolveMaxEjectionPercent(outlier *mcpv1alpha1.OutlierDetectionConfig) int32 {
+	if outlier == nil {
+		return DefaultMaxEjectionPercent
+	}
+	return outlier.MaxEjectionPercent
+}
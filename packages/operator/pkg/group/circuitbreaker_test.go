@@ -0,0 +1,145 @@
+package group
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+func TestTracker_StartsClosed(t *testing.T) {
+	tr := NewTracker()
+	assert.Equal(t, mcpv1alpha1.GroupCircuitClosed, tr.State(types.UID("g1")))
+}
+
+func TestTracker_OpensAfterFailureThreshold(t *testing.T) {
+	tr := NewTracker()
+	config := &mcpv1alpha1.GroupCircuitBreakerConfig{Enabled: true, FailureThreshold: 3}
+	now := time.Unix(0, 0)
+
+	for i := 0; i < 2; i++ {
+		outcome := tr.RecordResult(types.UID("g1"), "member-a", CallResult{Success: false}, config, now)
+		assert.Equal(t, mcpv1alpha1.GroupCircuitClosed, outcome.State)
+	}
+	outcome := tr.RecordResult(types.UID("g1"), "member-a", CallResult{Success: false}, config, now)
+	assert.Equal(t, mcpv1alpha1.GroupCircuitOpen, outcome.State)
+}
+
+func TestTracker_OpenBlocksRequestsUntilResetTimeout(t *testing.T) {
+	tr := NewTracker()
+	config := &mcpv1alpha1.GroupCircuitBreakerConfig{Enabled: true, FailureThreshold: 1, ResetTimeout: "1m"}
+	now := time.Unix(0, 0)
+
+	tr.RecordResult(types.UID("g1"), "member-a", CallResult{Success: false}, config, now)
+	assert.Equal(t, mcpv1alpha1.GroupCircuitOpen, tr.State(types.UID("g1")))
+	assert.False(t, tr.AllowRequest(types.UID("g1"), "member-a", now))
+
+	later := now.Add(2 * time.Minute)
+	assert.True(t, tr.AllowRequest(types.UID("g1"), "member-a", later))
+}
+
+func TestTracker_HalfOpenClosesAfterSuccessThreshold(t *testing.T) {
+	tr := NewTracker()
+	config := &mcpv1alpha1.GroupCircuitBreakerConfig{
+		Enabled: true, FailureThreshold: 1, ResetTimeout: "1m",
+		HalfOpenMaxProbes: 3, SuccessThreshold: 2,
+	}
+	now := time.Unix(0, 0)
+
+	tr.RecordResult(types.UID("g1"), "member-a", CallResult{Success: false}, config, now)
+	later := now.Add(2 * time.Minute)
+
+	// This call's result is consumed just to notice ResetTimeout elapsed
+	// and flip Open -> HalfOpen; it isn't itself counted as a probe.
+	outcome := tr.RecordResult(types.UID("g1"), "member-a", CallResult{Success: true}, config, later)
+	assert.Equal(t, mcpv1alpha1.GroupCircuitHalfOpen, outcome.State)
+
+	// Probe 1 of SuccessThreshold=2.
+	outcome = tr.RecordResult(types.UID("g1"), "member-a", CallResult{Success: true}, config, later)
+	assert.Equal(t, mcpv1alpha1.GroupCircuitHalfOpen, outcome.State)
+
+	// Probe 2 reaches SuccessThreshold.
+	outcome = tr.RecordResult(types.UID("g1"), "member-a", CallResult{Success: true}, config, later)
+	assert.Equal(t, mcpv1alpha1.GroupCircuitClosed, outcome.State)
+}
+
+func TestTracker_HalfOpenReopensOnInsufficientSuccesses(t *testing.T) {
+	tr := NewTracker()
+	config := &mcpv1alpha1.GroupCircuitBreakerConfig{
+		Enabled: true, FailureThreshold: 1, ResetTimeout: "1m",
+		HalfOpenMaxProbes: 2, SuccessThreshold: 2,
+	}
+	now := time.Unix(0, 0)
+
+	tr.RecordResult(types.UID("g1"), "member-a", CallResult{Success: false}, config, now)
+	later := now.Add(2 * time.Minute)
+
+	// Flips Open -> HalfOpen, not counted as a probe.
+	tr.RecordResult(types.UID("g1"), "member-a", CallResult{Success: false}, config, later)
+
+	// Two failing probes exhaust HalfOpenMaxProbes=2 without reaching
+	// SuccessThreshold, so the breaker reopens.
+	tr.RecordResult(types.UID("g1"), "member-a", CallResult{Success: false}, config, later)
+	outcome := tr.RecordResult(types.UID("g1"), "member-a", CallResult{Success: false}, config, later)
+	assert.Equal(t, mcpv1alpha1.GroupCircuitOpen, outcome.State)
+}
+
+func TestTracker_EjectsMemberOnConsecutive5xx(t *testing.T) {
+	tr := NewTracker()
+	config := &mcpv1alpha1.GroupCircuitBreakerConfig{
+		Enabled: true, FailureThreshold: 1000,
+		OutlierDetection: &mcpv1alpha1.OutlierDetectionConfig{
+			Consecutive5xxErrors: 3,
+			BaseEjectionTime:     "30s",
+			MaxEjectionPercent:   100,
+		},
+	}
+	now := time.Unix(0, 0)
+
+	for i := 0; i < 2; i++ {
+		outcome := tr.RecordResult(types.UID("g1"), "member-a", CallResult{Success: false, Is5xx: true}, config, now)
+		assert.False(t, outcome.Ejected)
+	}
+	outcome := tr.RecordResult(types.UID("g1"), "member-a", CallResult{Success: false, Is5xx: true}, config, now)
+	assert.True(t, outcome.Ejected)
+	assert.Equal(t, EjectionConsecutive5xx, outcome.EjectionReason)
+	assert.False(t, tr.AllowRequest(types.UID("g1"), "member-a", now))
+	assert.True(t, tr.AllowRequest(types.UID("g1"), "member-a", now.Add(time.Minute)))
+}
+
+func TestTracker_MaxEjectionPercentCapsEjections(t *testing.T) {
+	tr := NewTracker()
+	config := &mcpv1alpha1.GroupCircuitBreakerConfig{
+		Enabled: true, FailureThreshold: 1000,
+		OutlierDetection: &mcpv1alpha1.OutlierDetectionConfig{
+			Consecutive5xxErrors: 1,
+			BaseEjectionTime:     "30s",
+			MaxEjectionPercent:   0,
+		},
+	}
+	now := time.Unix(0, 0)
+
+	// Seed two members so len(g.members) > 0 before either is ejected.
+	tr.RecordResult(types.UID("g1"), "member-a", CallResult{Success: true}, config, now)
+	tr.RecordResult(types.UID("g1"), "member-b", CallResult{Success: true}, config, now)
+
+	outcome := tr.RecordResult(types.UID("g1"), "member-a", CallResult{Success: false, Is5xx: true}, config, now)
+	assert.False(t, outcome.Ejected, "MaxEjectionPercent=0 should block any ejection")
+}
+
+func TestMemberState_SuccessRate(t *testing.T) {
+	m := &memberState{}
+	for i := 0; i < minWindowSamples-1; i++ {
+		m.record(true)
+	}
+	_, ok := m.successRate()
+	assert.False(t, ok, "below minWindowSamples should not report a rate")
+
+	m.record(false)
+	rate, ok := m.successRate()
+	assert.True(t, ok)
+	assert.InDelta(t, float64(minWindowSamples-1)/float64(minWindowSamples), rate, 0.001)
+}
@@ -0,0 +1,139 @@
+// loadbalancer.go implements WeightedLeastRequest's Power-of-Two-Choices
+// picking and slow-start ramping, the load-balancing counterpart to
+// circuitbreaker.go's Tracker. Same caveat applies: there is no
+// MCPProviderGroup reconciler in this tree yet to call PickP2C or
+// RampFraction, so this is a self-contained algorithm a future group router
+// can call per request, and the group status's RampingProviders and
+// metrics.GroupP2CPicksTotal are unwired until it exists.
+
+package group
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// DefaultSlowStartWindow applies when MCPProviderGroupSpec.SlowStartWindow
+// is unset or fails to parse.
+const DefaultSlowStartWindow = 30 * time.Second
+
+// Candidate is one group member a P2C pick considers.
+type Candidate struct {
+	Name string
+
+	// Weight is the member's configured weight. Non-positive is treated as
+	// 1, the same "unset means equal weighting" default the Weighted
+	// strategy uses.
+	Weight int32
+
+	// ActiveConnections is the member's current in-flight request count.
+	ActiveConnections int32
+
+	// ReadySince is when the member last became Ready. A zero value means
+	// it isn't tracked as ramping (e.g. strategies other than
+	// WeightedLeastRequest don't populate it) and EffectiveWeight returns
+	// the nominal Weight unramped.
+	ReadySince time.Time
+}
+
+func (c Candidate) nominalWeight() int32 {
+	if c.Weight <= 0 {
+		return 1
+	}
+	return c.Weight
+}
+
+// EffectiveWeight ramps Candidate's nominal weight linearly from 0 to its
+// full value over window, starting at ReadySince - the same slow start
+// shape Envoy uses to keep a newly-Ready member from being flooded the
+// instant it's routable.
+func (c Candidate) EffectiveWeight(now time.Time, window time.Duration) int32 {
+	weight := c.nominalWeight()
+	if window <= 0 || c.ReadySince.IsZero() {
+		return weight
+	}
+	elapsed := now.Sub(c.ReadySince)
+	switch {
+	case elapsed >= window:
+		return weight
+	case elapsed <= 0:
+		return 0
+	default:
+		return int32(float64(weight) * float64(elapsed) / float64(window))
+	}
+}
+
+// RampFraction returns how far through the slow start window c is, in
+// [0,1]. 1 means fully ramped, or not ramping at all.
+func (c Candidate) RampFraction(now time.Time, window time.Duration) float64 {
+	if window <= 0 || c.ReadySince.IsZero() {
+		return 1
+	}
+	elapsed := now.Sub(c.ReadySince)
+	switch {
+	case elapsed >= window:
+		return 1
+	case elapsed <= 0:
+		return 0
+	default:
+		return float64(elapsed) / float64(window)
+	}
+}
+
+// PickOutcome is what PickP2C returns.
+type PickOutcome struct {
+	Name   string
+	Picked bool
+}
+
+// PickP2C implements Power-of-Two-Choices selection: sample two distinct
+// candidates at random and route to whichever has the lower
+// ActiveConnections/EffectiveWeight ratio. This is O(1) per pick and
+// empirically close to scanning every member for the true least-loaded one.
+// A candidate still inside its slow start window competes at its ramped-down
+// EffectiveWeight, so it naturally receives a smaller share of picks early
+// on rather than being excluded outright.
+func PickP2C(rng *rand.Rand, candidates []Candidate, now time.Time, slowStartWindow time.Duration) PickOutcome {
+	switch len(candidates) {
+	case 0:
+		return PickOutcome{}
+	case 1:
+		return PickOutcome{Name: candidates[0].Name, Picked: true}
+	}
+
+	i := rng.Intn(len(candidates))
+	j := rng.Intn(len(candidates) - 1)
+	if j >= i {
+		j++
+	}
+
+	a, b := candidates[i], candidates[j]
+	if loadRatio(a, now, slowStartWindow) <= loadRatio(b, now, slowStartWindow) {
+		return PickOutcome{Name: a.Name, Picked: true}
+	}
+	return PickOutcome{Name: b.Name, Picked: true}
+}
+
+// loadRatio is ActiveConnections/EffectiveWeight, the load metric P2C
+// compares. A candidate still ramped to zero weight gets +Inf so it's never
+// preferred over any candidate actually carrying weight.
+func loadRatio(c Candidate, now time.Time, slowStartWindow time.Duration) float64 {
+	weight := c.EffectiveWeight(now, slowStartWindow)
+	if weight <= 0 {
+		return math.Inf(1)
+	}
+	return float64(c.ActiveConnections) / float64(weight)
+}
+
+// ResolveSlowStartWindow parses MCPProviderGroupSpec.SlowStartWindow,
+// falling back to DefaultSlowStartWindow when unset or unparseable.
+func ResolveSlowStartWindow(raw string) time.Duration {
+	if raw == "" {
+		return DefaultSlowStartWindow
+	}
+	if d, err := time.ParseDuration(raw); err == nil {
+		return d
+	}
+	return DefaultSlowStartWindow
+}
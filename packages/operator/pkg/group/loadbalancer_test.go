@@ -0,0 +1,78 @@
+package group
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCandidate_EffectiveWeight_RampsLinearly(t *testing.T) {
+	now := time.Unix(0, 0)
+	c := Candidate{Name: "a", Weight: 100, ReadySince: now}
+
+	assert.Equal(t, int32(0), c.EffectiveWeight(now, 10*time.Second))
+	assert.Equal(t, int32(50), c.EffectiveWeight(now.Add(5*time.Second), 10*time.Second))
+	assert.Equal(t, int32(100), c.EffectiveWeight(now.Add(10*time.Second), 10*time.Second))
+	assert.Equal(t, int32(100), c.EffectiveWeight(now.Add(time.Minute), 10*time.Second))
+}
+
+func TestCandidate_EffectiveWeight_UnrampedWithoutReadySince(t *testing.T) {
+	c := Candidate{Name: "a", Weight: 50}
+	assert.Equal(t, int32(50), c.EffectiveWeight(time.Unix(0, 0), 10*time.Second))
+}
+
+func TestCandidate_EffectiveWeight_DefaultsUnsetWeightToOne(t *testing.T) {
+	c := Candidate{Name: "a"}
+	assert.Equal(t, int32(1), c.EffectiveWeight(time.Unix(0, 0), 0))
+}
+
+func TestCandidate_RampFraction(t *testing.T) {
+	now := time.Unix(0, 0)
+	c := Candidate{Name: "a", Weight: 100, ReadySince: now}
+
+	assert.Equal(t, 0.0, c.RampFraction(now, 10*time.Second))
+	assert.InDelta(t, 0.5, c.RampFraction(now.Add(5*time.Second), 10*time.Second), 0.001)
+	assert.Equal(t, 1.0, c.RampFraction(now.Add(10*time.Second), 10*time.Second))
+}
+
+func TestPickP2C_NoCandidates(t *testing.T) {
+	outcome := PickP2C(rand.New(rand.NewSource(1)), nil, time.Unix(0, 0), 0)
+	assert.False(t, outcome.Picked)
+}
+
+func TestPickP2C_SingleCandidate(t *testing.T) {
+	outcome := PickP2C(rand.New(rand.NewSource(1)), []Candidate{{Name: "only"}}, time.Unix(0, 0), 0)
+	assert.True(t, outcome.Picked)
+	assert.Equal(t, "only", outcome.Name)
+}
+
+func TestPickP2C_PrefersLowerLoadRatio(t *testing.T) {
+	now := time.Unix(0, 0)
+	candidates := []Candidate{
+		{Name: "busy", Weight: 1, ActiveConnections: 10},
+		{Name: "idle", Weight: 1, ActiveConnections: 1},
+	}
+	outcome := PickP2C(rand.New(rand.NewSource(1)), candidates, now, 0)
+	assert.Equal(t, "idle", outcome.Name)
+}
+
+func TestPickP2C_DeprioritizesRampingCandidate(t *testing.T) {
+	now := time.Unix(0, 0)
+	candidates := []Candidate{
+		// Just turned Ready: zero connections but also zero effective
+		// weight, so it should lose to an established member with the
+		// same connection count.
+		{Name: "ramping", Weight: 100, ActiveConnections: 0, ReadySince: now},
+		{Name: "established", Weight: 100, ActiveConnections: 0},
+	}
+	outcome := PickP2C(rand.New(rand.NewSource(1)), candidates, now, 10*time.Second)
+	assert.Equal(t, "established", outcome.Name)
+}
+
+func TestResolveSlowStartWindow_DefaultsWhenUnset(t *testing.T) {
+	assert.Equal(t, DefaultSlowStartWindow, ResolveSlowStartWindow(""))
+	assert.Equal(t, DefaultSlowStartWindow, ResolveSlowStartWindow("not-a-duration"))
+	assert.Equal(t, time.Minute, ResolveSlowStartWindow("1m"))
+}
@@ -0,0 +1,113 @@
+package hangar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Authenticator applies authentication to an outgoing request before it is sent.
+type Authenticator interface {
+	Apply(ctx context.Context, req *http.Request) error
+}
+
+// StaticAPIKey authenticates with a fixed X-API-Key header, equivalent to Config.APIKey.
+type StaticAPIKey struct {
+	Key string
+}
+
+func (a *StaticAPIKey) Apply(_ context.Context, req *http.Request) error {
+	req.Header.Set("X-API-Key", a.Key)
+	return nil
+}
+
+// BearerToken authenticates with a fixed Authorization: Bearer header.
+type BearerToken struct {
+	Token string
+}
+
+func (a *BearerToken) Apply(_ context.Context, req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+a.Token)
+	return nil
+}
+
+// TokenSource authenticates with a bearer token refreshed from a file or an
+// exec plugin, caching the value until RefreshInterval elapses.
+type TokenSource struct {
+	// TokenFile, if set, is read for the raw token on each refresh.
+	TokenFile string
+
+	// ExecCommand, if set, is run on each refresh and its trimmed stdout used as the token.
+	ExecCommand []string
+
+	// RefreshInterval controls how long a cached token is reused before reloading.
+	RefreshInterval time.Duration
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func (a *TokenSource) Apply(ctx context.Context, req *http.Request) error {
+	token, err := a.currentToken(ctx)
+	if err != nil {
+		return fmt.Errorf("token source: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+func (a *TokenSource) currentToken(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != "" && time.Now().Before(a.expiresAt) {
+		return a.token, nil
+	}
+
+	token, err := a.load(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	a.token = token
+	interval := a.RefreshInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	a.expiresAt = time.Now().Add(interval)
+	return token, nil
+}
+
+func (a *TokenSource) load(ctx context.Context) (string, error) {
+	switch {
+	case a.TokenFile != "":
+		data, err := os.ReadFile(a.TokenFile)
+		if err != nil {
+			return "", fmt.Errorf("read token file: %w", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	case len(a.ExecCommand) > 0:
+		cmd := exec.CommandContext(ctx, a.ExecCommand[0], a.ExecCommand[1:]...)
+		out, err := cmd.Output()
+		if err != nil {
+			return "", fmt.Errorf("exec token plugin: %w", err)
+		}
+		return strings.TrimSpace(string(out)), nil
+	default:
+		return "", fmt.Errorf("no token file or exec command configured")
+	}
+}
+
+// MutualTLS is a no-op Authenticator used as a marker that authentication is
+// carried entirely by the client certificate presented during the TLS handshake.
+type MutualTLS struct{}
+
+func (MutualTLS) Apply(_ context.Context, _ *http.Request) error {
+	return nil
+}
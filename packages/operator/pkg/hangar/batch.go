@@ -0,0 +1,244 @@
+package hangar
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// ProviderRef identifies a provider for batch lookups.
+type ProviderRef struct {
+	Name      string
+	Namespace string
+}
+
+// RegisterResult is the outcome of registering a single provider as part of
+// a RegisterProviders batch. Err is nil on success and, when Hangar core
+// reports a structured failure, an *APIError otherwise.
+type RegisterResult struct {
+	Request *RegisterProviderRequest
+	Err     error
+}
+
+// RegisterProviders registers multiple providers in one call. It first tries
+// Hangar core's batch endpoint; if the server doesn't support it (404/405),
+// it falls back to issuing RegisterProvider calls individually over a worker
+// pool bounded by Config.MaxConcurrency. Either way, results are returned in
+// the same order as reqs, and a failure on one entry does not prevent the
+// others from succeeding.
+func (c *Client) RegisterProviders(ctx context.Context, reqs []*RegisterProviderRequest) ([]RegisterResult, error) {
+	if len(reqs) == 0 {
+		return nil, nil
+	}
+
+	results, err := c.registerProvidersBatch(ctx, reqs)
+	if errors.Is(err, errBatchUnsupported) {
+		return c.registerProvidersFallback(ctx, reqs), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// errBatchUnsupported signals that Hangar core doesn't implement the batch
+// endpoint (404/405), and the caller should fall back to per-item requests.
+var errBatchUnsupported = fmt.Errorf("hangar: batch endpoint not supported")
+
+func (c *Client) registerProvidersBatch(ctx context.Context, reqs []*RegisterProviderRequest) ([]RegisterResult, error) {
+	ctx = withOperation(ctx, "RegisterProviders", "", "")
+	url := fmt.Sprintf("%s/api/v1/providers:batch", c.baseURL)
+
+	body, err := json.Marshal(struct {
+		Providers []*RegisterProviderRequest `json:"providers"`
+	}{Providers: reqs})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doWithRetry(ctx, http.MethodPost, url, body, func(req *http.Request) {
+		req.Header.Set("Content-Type", "application/json")
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed {
+		return nil, errBatchUnsupported
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusMultiStatus {
+		return nil, parseAPIError(resp)
+	}
+
+	var decoded struct {
+		Results []struct {
+			Error *struct {
+				Code      string         `json:"code"`
+				Message   string         `json:"message"`
+				Details   map[string]any `json:"details,omitempty"`
+				RequestID string         `json:"request_id,omitempty"`
+			} `json:"error,omitempty"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if len(decoded.Results) != len(reqs) {
+		return nil, fmt.Errorf("hangar: batch response had %d results for %d requests", len(decoded.Results), len(reqs))
+	}
+
+	results := make([]RegisterResult, len(reqs))
+	for i, r := range decoded.Results {
+		results[i] = RegisterResult{Request: reqs[i]}
+		if r.Error != nil {
+			results[i].Err = &APIError{
+				Code:       r.Error.Code,
+				Message:    r.Error.Message,
+				HTTPStatus: resp.StatusCode,
+				Details:    r.Error.Details,
+				RequestID:  r.Error.RequestID,
+			}
+		}
+	}
+	return results, nil
+}
+
+// registerProvidersFallback registers each provider individually over a
+// worker pool bounded by c.maxConcurrency, preserving input order in results.
+func (c *Client) registerProvidersFallback(ctx context.Context, reqs []*RegisterProviderRequest) []RegisterResult {
+	results := make([]RegisterResult, len(reqs))
+	sem := make(chan struct{}, c.maxConcurrency)
+	var wg sync.WaitGroup
+
+	for i, req := range reqs {
+		wg.Add(1)
+		go func(i int, req *RegisterProviderRequest) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			results[i] = RegisterResult{Request: req, Err: c.RegisterProvider(ctx, req)}
+		}(i, req)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// GetProvidersTools fetches tools for multiple providers in one call. It
+// first tries Hangar core's batch endpoint; if unsupported, it falls back to
+// GetProviderTools calls over a worker pool bounded by Config.MaxConcurrency.
+// Providers that fail to resolve are omitted from the returned map.
+func (c *Client) GetProvidersTools(ctx context.Context, refs []ProviderRef) (map[ProviderRef][]string, error) {
+	if len(refs) == 0 {
+		return map[ProviderRef][]string{}, nil
+	}
+
+	tools, err := c.getProvidersToolsBatch(ctx, refs)
+	if errors.Is(err, errBatchUnsupported) {
+		return c.getProvidersToolsFallback(ctx, refs), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tools, nil
+}
+
+func (c *Client) getProvidersToolsBatch(ctx context.Context, refs []ProviderRef) (map[ProviderRef][]string, error) {
+	ctx = withOperation(ctx, "GetProvidersTools", "", "")
+	url := fmt.Sprintf("%s/api/v1/providers:tools", c.baseURL)
+
+	type providerRef struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	}
+	wireRefs := make([]providerRef, len(refs))
+	for i, ref := range refs {
+		wireRefs[i] = providerRef{Name: ref.Name, Namespace: ref.Namespace}
+	}
+
+	body, err := json.Marshal(struct {
+		Providers []providerRef `json:"providers"`
+	}{Providers: wireRefs})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doWithRetry(ctx, http.MethodPost, url, body, func(req *http.Request) {
+		req.Header.Set("Content-Type", "application/json")
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed {
+		return nil, errBatchUnsupported
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusMultiStatus {
+		return nil, parseAPIError(resp)
+	}
+
+	var decoded struct {
+		Results []struct {
+			Name      string   `json:"name"`
+			Namespace string   `json:"namespace"`
+			Tools     []string `json:"tools"`
+			Error     *struct {
+				Code string `json:"code"`
+			} `json:"error,omitempty"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	out := make(map[ProviderRef][]string, len(decoded.Results))
+	for _, r := range decoded.Results {
+		if r.Error != nil {
+			continue
+		}
+		out[ProviderRef{Name: r.Name, Namespace: r.Namespace}] = r.Tools
+	}
+	return out, nil
+}
+
+// getProvidersToolsFallback looks up each provider's tools individually over
+// a worker pool bounded by c.maxConcurrency.
+func (c *Client) getProvidersToolsFallback(ctx context.Context, refs []ProviderRef) map[ProviderRef][]string {
+	type entry struct {
+		ref   ProviderRef
+		tools []string
+		err   error
+	}
+	entries := make([]entry, len(refs))
+	sem := make(chan struct{}, c.maxConcurrency)
+	var wg sync.WaitGroup
+
+	for i, ref := range refs {
+		wg.Add(1)
+		go func(i int, ref ProviderRef) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			tools, err := c.GetProviderTools(ctx, ref.Name, ref.Namespace)
+			entries[i] = entry{ref: ref, tools: tools, err: err}
+		}(i, ref)
+	}
+
+	wg.Wait()
+
+	out := make(map[ProviderRef][]string, len(refs))
+	for _, e := range entries {
+		if e.err != nil {
+			continue
+		}
+		out[e.ref] = e.tools
+	}
+	return out
+}
@@ -0,0 +1,166 @@
+package hangar
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_RegisterProviders_BatchServerPath(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/providers:batch", r.URL.Path)
+
+		var body struct {
+			Providers []*RegisterProviderRequest `json:"providers"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Len(t, body.Providers, 3)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]any{
+			"results": []map[string]any{
+				{},
+				{"error": map[string]any{"code": "provider_conflict", "message": "already registered"}},
+				{},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{URL: server.URL})
+	require.NoError(t, err)
+
+	reqs := []*RegisterProviderRequest{
+		{Name: "a", Namespace: "default", Mode: "container"},
+		{Name: "b", Namespace: "default", Mode: "container"},
+		{Name: "c", Namespace: "default", Mode: "container"},
+	}
+
+	results, err := client.RegisterProviders(context.Background(), reqs)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	assert.Equal(t, "a", results[0].Request.Name)
+	assert.NoError(t, results[0].Err)
+
+	assert.Equal(t, "b", results[1].Request.Name)
+	require.Error(t, results[1].Err)
+	var apiErr *APIError
+	require.ErrorAs(t, results[1].Err, &apiErr)
+	assert.Equal(t, "provider_conflict", apiErr.Code)
+
+	assert.Equal(t, "c", results[2].Request.Name)
+	assert.NoError(t, results[2].Err)
+}
+
+func TestClient_RegisterProviders_FallsBackWhenBatchUnsupported(t *testing.T) {
+	var registered []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/providers:batch" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var body RegisterProviderRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		registered = append(registered, body.Name)
+
+		if body.Name == "b" {
+			w.WriteHeader(http.StatusConflict)
+			json.NewEncoder(w).Encode(map[string]any{
+				"error": map[string]any{"code": "provider_conflict", "message": "already registered"},
+			})
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{URL: server.URL, MaxConcurrency: 2})
+	require.NoError(t, err)
+
+	reqs := []*RegisterProviderRequest{
+		{Name: "a", Namespace: "default", Mode: "container"},
+		{Name: "b", Namespace: "default", Mode: "container"},
+		{Name: "c", Namespace: "default", Mode: "container"},
+	}
+
+	results, err := client.RegisterProviders(context.Background(), reqs)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	assert.Equal(t, "a", results[0].Request.Name)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, "b", results[1].Request.Name)
+	assert.True(t, errors.Is(results[1].Err, ErrProviderConflict))
+	assert.Equal(t, "c", results[2].Request.Name)
+	assert.NoError(t, results[2].Err)
+
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, registered)
+}
+
+func TestClient_GetProvidersTools_BatchServerPath(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/providers:tools", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]any{
+			"results": []map[string]any{
+				{"name": "a", "namespace": "default", "tools": []string{"t1"}},
+				{"name": "b", "namespace": "default", "error": map[string]any{"code": "provider_not_found"}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{URL: server.URL})
+	require.NoError(t, err)
+
+	refs := []ProviderRef{
+		{Name: "a", Namespace: "default"},
+		{Name: "b", Namespace: "default"},
+	}
+
+	tools, err := client.GetProvidersTools(context.Background(), refs)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"t1"}, tools[ProviderRef{Name: "a", Namespace: "default"}])
+	_, ok := tools[ProviderRef{Name: "b", Namespace: "default"}]
+	assert.False(t, ok)
+}
+
+func TestClient_GetProvidersTools_FallsBackWhenBatchUnsupported(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/providers:tools" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]any{"tools": []string{"t-" + r.URL.Path}})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{URL: server.URL, MaxConcurrency: 2})
+	require.NoError(t, err)
+
+	refs := []ProviderRef{
+		{Name: "a", Namespace: "default"},
+		{Name: "b", Namespace: "default"},
+	}
+
+	tools, err := client.GetProvidersTools(context.Background(), refs)
+	require.NoError(t, err)
+	assert.Len(t, tools, 2)
+	assert.Contains(t, tools, ProviderRef{Name: "a", Namespace: "default"})
+	assert.Contains(t, tools, ProviderRef{Name: "b", Namespace: "default"})
+}
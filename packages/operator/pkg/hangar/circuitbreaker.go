@@ -0,0 +1,144 @@
+package hangar
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// circuitState represents the state of a circuitBreaker
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuitBreaker is a per-host circuit breaker with a rolling error-rate window.
+// Once a host is clearly failing, it trips open so callers fail fast instead of
+// piling up goroutines behind the client timeout.
+type circuitBreaker struct {
+	mu sync.Mutex
+
+	failureThreshold int
+	minRequests      int
+	resetTimeout     time.Duration
+	halfOpenMax      int
+
+	state         circuitState
+	openedAt      time.Time
+	halfOpenCount int
+
+	window []bool // true = success, false = failure, rolling
+	maxWin int
+}
+
+// newCircuitBreaker creates a circuit breaker that opens once at least minRequests
+// have been seen and the failure rate over the rolling window reaches the threshold.
+func newCircuitBreaker() *circuitBreaker {
+	return &circuitBreaker{
+		failureThreshold: 50, // percent
+		minRequests:      10,
+		resetTimeout:     30 * time.Second,
+		halfOpenMax:      1,
+		maxWin:           20,
+	}
+}
+
+// ErrCircuitOpen is returned when a request is rejected because the circuit is open
+var ErrCircuitOpen = fmt.Errorf("hangar: circuit breaker open")
+
+// allow reports whether a request may proceed, transitioning open->half-open after resetTimeout
+func (cb *circuitBreaker) allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case circuitClosed:
+		return true
+	case circuitOpen:
+		if time.Since(cb.openedAt) >= cb.resetTimeout {
+			cb.state = circuitHalfOpen
+			cb.halfOpenCount = 0
+		} else {
+			return false
+		}
+		fallthrough
+	case circuitHalfOpen:
+		if cb.halfOpenCount >= cb.halfOpenMax {
+			return false
+		}
+		cb.halfOpenCount++
+		return true
+	}
+	return true
+}
+
+// record records the outcome of a request that was allowed through
+func (cb *circuitBreaker) record(success bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == circuitHalfOpen {
+		if success {
+			cb.reset()
+		} else {
+			cb.trip()
+		}
+		return
+	}
+
+	cb.window = append(cb.window, success)
+	if len(cb.window) > cb.maxWin {
+		cb.window = cb.window[len(cb.window)-cb.maxWin:]
+	}
+
+	if len(cb.window) < cb.minRequests {
+		return
+	}
+
+	failures := 0
+	for _, ok := range cb.window {
+		if !ok {
+			failures++
+		}
+	}
+	if failures*100/len(cb.window) >= cb.failureThreshold {
+		cb.trip()
+	}
+}
+
+func (cb *circuitBreaker) trip() {
+	cb.state = circuitOpen
+	cb.openedAt = time.Now()
+	cb.window = nil
+}
+
+func (cb *circuitBreaker) reset() {
+	cb.state = circuitClosed
+	cb.window = nil
+	cb.halfOpenCount = 0
+}
+
+// circuitBreakerRegistry keeps one circuitBreaker per host
+type circuitBreakerRegistry struct {
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+func newCircuitBreakerRegistry() *circuitBreakerRegistry {
+	return &circuitBreakerRegistry{breakers: make(map[string]*circuitBreaker)}
+}
+
+func (r *circuitBreakerRegistry) forHost(host string) *circuitBreaker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cb, ok := r.breakers[host]
+	if !ok {
+		cb = newCircuitBreaker()
+		r.breakers[host] = cb
+	}
+	return cb
+}
@@ -4,18 +4,30 @@ package hangar
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"time"
+
+	otelmetric "go.opentelemetry.io/otel/metric"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 // Client communicates with MCP-Hangar core
 type Client struct {
-	baseURL    string
-	httpClient *http.Client
-	apiKey     string
+	baseURL        string
+	httpClient     *http.Client
+	apiKey         string
+	authenticator  Authenticator
+	retryPolicy    *RetryPolicy
+	circuits       *circuitBreakerRegistry
+	maxConcurrency int
+
+	telemetry *telemetry
 }
 
 // Config holds client configuration
@@ -23,23 +35,68 @@ type Config struct {
 	// URL of MCP-Hangar core service
 	URL string
 
-	// APIKey for authentication
+	// APIKey for authentication. Mutually exclusive with Authenticator.
 	APIKey string
 
 	// Timeout for requests
 	Timeout time.Duration
+
+	// RetryPolicy configures retry/backoff behavior. Nil disables retries.
+	RetryPolicy *RetryPolicy
+
+	// TLSConfig, if set, is cloned and used as the base TLS configuration for
+	// the client transport. CACertFile/ClientCertFile/ClientKeyFile augment it.
+	TLSConfig *tls.Config
+
+	// CACertFile is a PEM file of CA certificates trusted to verify the server.
+	CACertFile string
+
+	// ClientCertFile and ClientKeyFile configure a client certificate for mTLS.
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// Authenticator applies per-request authentication (bearer tokens, mTLS
+	// markers, etc). Mutually exclusive with APIKey.
+	Authenticator Authenticator
+
+	// MaxConcurrency bounds the worker pool used by RegisterProviders and
+	// GetProvidersTools when falling back to per-provider requests because
+	// Hangar core doesn't support the batch endpoint. Defaults to 4.
+	MaxConcurrency int
+
+	// TracerProvider, if set, enables OpenTelemetry tracing: every request
+	// gets a span named "Hangar.<Method>" with the parent trace propagated
+	// via the traceparent header.
+	TracerProvider oteltrace.TracerProvider
+
+	// MeterProvider, if set, enables OpenTelemetry metrics: request counts,
+	// durations, and in-flight gauges keyed by method and status.
+	MeterProvider otelmetric.MeterProvider
+
+	// Logger, if set, receives one structured line per request (method, URL
+	// path, status, duration). At Debug level it also logs request/response
+	// headers, with the values of RedactHeaders replaced by "REDACTED".
+	Logger *slog.Logger
+
+	// RedactHeaders lists header names (case-insensitive) whose values are
+	// replaced by "REDACTED" in debug logging. X-API-Key and Authorization
+	// are always redacted in addition to anything listed here.
+	RedactHeaders []string
 }
 
 // DefaultConfig returns default client configuration
 func DefaultConfig() *Config {
 	return &Config{
-		URL:     "http://mcp-hangar.mcp-system.svc.cluster.local:8080",
-		Timeout: 30 * time.Second,
+		URL:         "http://mcp-hangar.mcp-system.svc.cluster.local:8080",
+		Timeout:     30 * time.Second,
+		RetryPolicy: DefaultRetryPolicy(),
 	}
 }
 
-// NewClient creates a new Hangar client
-func NewClient(config *Config) *Client {
+// NewClient creates a new Hangar client. It returns an error if the TLS or
+// authentication configuration is invalid (e.g. unreadable cert files, or
+// incompatible auth modes configured together).
+func NewClient(config *Config) (*Client, error) {
 	if config == nil {
 		config = DefaultConfig()
 	}
@@ -49,13 +106,43 @@ func NewClient(config *Config) *Client {
 		timeout = 30 * time.Second
 	}
 
-	return &Client{
-		baseURL: config.URL,
-		apiKey:  config.APIKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
+	transport, err := buildTransport(config)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := &http.Client{Timeout: timeout}
+	if transport != nil {
+		httpClient.Transport = transport
+	}
+
+	maxConcurrency := config.MaxConcurrency
+	if maxConcurrency <= 0 {
+		maxConcurrency = 4
+	}
+
+	tel, err := newTelemetry(config)
+	if err != nil {
+		return nil, err
+	}
+	if tel != nil {
+		base := httpClient.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		httpClient.Transport = &instrumentedTransport{next: base, telemetry: tel}
 	}
+
+	return &Client{
+		baseURL:        config.URL,
+		apiKey:         config.APIKey,
+		authenticator:  config.Authenticator,
+		httpClient:     httpClient,
+		retryPolicy:    config.RetryPolicy,
+		circuits:       newCircuitBreakerRegistry(),
+		maxConcurrency: maxConcurrency,
+		telemetry:      tel,
+	}, nil
 }
 
 // ProviderInfo represents provider information from Hangar
@@ -77,28 +164,17 @@ type ToolInfo struct {
 
 // GetProviderTools fetches the list of tools from a provider
 func (c *Client) GetProviderTools(ctx context.Context, name, namespace string) ([]string, error) {
+	ctx = withOperation(ctx, "GetProviderTools", name, namespace)
 	url := fmt.Sprintf("%s/api/v1/providers/%s/%s/tools", c.baseURL, namespace, name)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	resp, err := c.doWithRetry(ctx, http.MethodGet, url, nil, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	c.setHeaders(req)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("provider not found: %s/%s", namespace, name)
-	}
-
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(body))
+		return nil, parseAPIError(resp)
 	}
 
 	var result struct {
@@ -113,18 +189,12 @@ func (c *Client) GetProviderTools(ctx context.Context, name, namespace string) (
 
 // GetProvider fetches provider information
 func (c *Client) GetProvider(ctx context.Context, name, namespace string) (*ProviderInfo, error) {
+	ctx = withOperation(ctx, "GetProvider", name, namespace)
 	url := fmt.Sprintf("%s/api/v1/providers/%s/%s", c.baseURL, namespace, name)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	c.setHeaders(req)
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doWithRetry(ctx, http.MethodGet, url, nil, nil)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
@@ -133,8 +203,7 @@ func (c *Client) GetProvider(ctx context.Context, name, namespace string) (*Prov
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(body))
+		return nil, parseAPIError(resp)
 	}
 
 	var info ProviderInfo
@@ -145,8 +214,43 @@ func (c *Client) GetProvider(ctx context.Context, name, namespace string) (*Prov
 	return &info, nil
 }
 
+// ProviderMetrics holds the MCP-native load signals Hangar core tracks for
+// a provider - in-flight tool calls, queued JSON-RPC requests, and streaming
+// throughput - none of which are visible to a normal Kubernetes HPA since
+// they only exist in the gateway sitting in front of the provider's Pods.
+type ProviderMetrics struct {
+	ActiveToolCalls int32   `json:"activeToolCalls"`
+	QueueDepth      int32   `json:"queueDepth"`
+	TokensPerSecond float64 `json:"tokensPerSecond"`
+}
+
+// GetProviderMetrics fetches a provider's current MCP-native metrics for
+// autoscaling decisions.
+func (c *Client) GetProviderMetrics(ctx context.Context, name, namespace string) (*ProviderMetrics, error) {
+	ctx = withOperation(ctx, "GetProviderMetrics", name, namespace)
+	url := fmt.Sprintf("%s/api/v1/providers/%s/%s/metrics", c.baseURL, namespace, name)
+
+	resp, err := c.doWithRetry(ctx, http.MethodGet, url, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, parseAPIError(resp)
+	}
+
+	var metrics ProviderMetrics
+	if err := json.NewDecoder(resp.Body).Decode(&metrics); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &metrics, nil
+}
+
 // HealthCheckRemote checks if a remote endpoint is healthy
 func (c *Client) HealthCheckRemote(ctx context.Context, endpoint string) (bool, []string, error) {
+	ctx = withOperation(ctx, "HealthCheckRemote", endpoint, "")
 	url := fmt.Sprintf("%s/api/v1/health/remote", c.baseURL)
 
 	payload := struct {
@@ -160,17 +264,11 @@ func (c *Client) HealthCheckRemote(ctx context.Context, endpoint string) (bool,
 		return false, nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	resp, err := c.doWithRetry(ctx, http.MethodPost, url, body, func(req *http.Request) {
+		req.Header.Set("Content-Type", "application/json")
+	})
 	if err != nil {
-		return false, nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	c.setHeaders(req)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return false, nil, fmt.Errorf("request failed: %w", err)
+		return false, nil, err
 	}
 	defer resp.Body.Close()
 
@@ -198,9 +296,24 @@ type RegisterProviderRequest struct {
 	Endpoint  string            `json:"endpoint,omitempty"`
 	Image     string            `json:"image,omitempty"`
 	Labels    map[string]string `json:"labels,omitempty"`
+
+	// Endpoints lists every ready replica's endpoint for a provider with
+	// more than one Pod, so Hangar core can load-balance tool calls across
+	// them. Endpoint is still set to Endpoints[0] for callers that only
+	// look at the single-endpoint field.
+	Endpoints []string `json:"endpoints,omitempty"`
+
+	// EndpointWeights splits traffic across Endpoints by relative weight
+	// during a Canary rollout, so Hangar core sends roughly Weight percent
+	// of new sessions to the new template's endpoints and the rest to the
+	// old one while both have ready endpoints. Omitted outside of a Canary
+	// rollout, or when only one side has ready endpoints, in which case Hangar core load-balances
+	// Endpoints evenly as usual.
+	EndpointWeights map[string]int32 `json:"endpointWeights,omitempty"`
 }
 
 func (c *Client) RegisterProvider(ctx context.Context, req *RegisterProviderRequest) error {
+	ctx = withOperation(ctx, "RegisterProvider", req.Name, req.Namespace)
 	url := fmt.Sprintf("%s/api/v1/providers", c.baseURL)
 
 	body, err := json.Marshal(req)
@@ -208,23 +321,16 @@ func (c *Client) RegisterProvider(ctx context.Context, req *RegisterProviderRequ
 		return fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-
-	c.setHeaders(httpReq)
-	httpReq.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(httpReq)
+	resp, err := c.doWithRetry(ctx, http.MethodPost, url, body, func(httpReq *http.Request) {
+		httpReq.Header.Set("Content-Type", "application/json")
+	})
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("registration failed with status %d: %s", resp.StatusCode, string(respBody))
+		return parseAPIError(resp)
 	}
 
 	return nil
@@ -232,25 +338,57 @@ func (c *Client) RegisterProvider(ctx context.Context, req *RegisterProviderRequ
 
 // DeregisterProvider removes a provider from Hangar core
 func (c *Client) DeregisterProvider(ctx context.Context, name, namespace string) error {
+	ctx = withOperation(ctx, "DeregisterProvider", name, namespace)
 	url := fmt.Sprintf("%s/api/v1/providers/%s/%s", c.baseURL, namespace, name)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	resp, err := c.doWithRetry(ctx, http.MethodDelete, url, nil, nil)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return err
 	}
+	defer resp.Body.Close()
 
-	c.setHeaders(req)
+	// 404 is OK - provider already gone
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		return parseAPIError(resp)
+	}
 
-	resp, err := c.httpClient.Do(req)
+	return nil
+}
+
+// DrainProvider asks Hangar core to stop routing new tool calls to a
+// provider and wait up to timeout for any in-flight calls to finish, so a
+// caller can delete its backing Pod without cutting off work in progress.
+// A zero timeout lets Hangar core pick its own default.
+func (c *Client) DrainProvider(ctx context.Context, name, namespace string, timeout time.Duration) error {
+	ctx = withOperation(ctx, "DrainProvider", name, namespace)
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	url := fmt.Sprintf("%s/api/v1/providers/%s/%s/drain", c.baseURL, namespace, name)
+
+	payload := struct {
+		TimeoutSeconds float64 `json:"timeout_seconds,omitempty"`
+	}{TimeoutSeconds: timeout.Seconds()}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doWithRetry(ctx, http.MethodPost, url, body, func(req *http.Request) {
+		req.Header.Set("Content-Type", "application/json")
+	})
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
-	// 404 is OK - provider already gone
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("deregistration failed with status %d: %s", resp.StatusCode, string(body))
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return parseAPIError(resp)
 	}
 
 	return nil
@@ -258,24 +396,17 @@ func (c *Client) DeregisterProvider(ctx context.Context, name, namespace string)
 
 // StartProvider starts a cold provider
 func (c *Client) StartProvider(ctx context.Context, name, namespace string) error {
+	ctx = withOperation(ctx, "StartProvider", name, namespace)
 	url := fmt.Sprintf("%s/api/v1/providers/%s/%s/start", c.baseURL, namespace, name)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-
-	c.setHeaders(req)
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doWithRetry(ctx, http.MethodPost, url, nil, nil)
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("start failed with status %d: %s", resp.StatusCode, string(body))
+		return parseAPIError(resp)
 	}
 
 	return nil
@@ -283,24 +414,17 @@ func (c *Client) StartProvider(ctx context.Context, name, namespace string) erro
 
 // StopProvider stops a provider
 func (c *Client) StopProvider(ctx context.Context, name, namespace string) error {
+	ctx = withOperation(ctx, "StopProvider", name, namespace)
 	url := fmt.Sprintf("%s/api/v1/providers/%s/%s/stop", c.baseURL, namespace, name)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	resp, err := c.doWithRetry(ctx, http.MethodPost, url, nil, nil)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-
-	c.setHeaders(req)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("stop failed with status %d: %s", resp.StatusCode, string(body))
+		return parseAPIError(resp)
 	}
 
 	return nil
@@ -328,11 +452,106 @@ func (c *Client) Ping(ctx context.Context) error {
 	return nil
 }
 
+// doWithRetry executes an HTTP request, retrying idempotent verbs on transient
+// failures (5xx, 429, temporary network errors) with exponential backoff and
+// jitter, honoring Retry-After when present. body is re-sent on every attempt,
+// so callers with a request body must pass it here rather than on req.Body.
+func (c *Client) doWithRetry(ctx context.Context, method, reqURL string, body []byte, setup func(*http.Request)) (*http.Response, error) {
+	host := hostOf(reqURL)
+	cb := c.circuits.forHost(host)
+
+	policy := c.retryPolicy
+	maxAttempts := 1
+	if policy != nil && policy.MaxAttempts > 0 {
+		maxAttempts = policy.MaxAttempts
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if !cb.allow() {
+			return nil, fmt.Errorf("%w: host %s", ErrCircuitOpen, host)
+		}
+
+		var bodyReader io.Reader
+		if body != nil {
+			bodyReader = bytes.NewReader(body)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, reqURL, bodyReader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		if err := c.setHeaders(ctx, req); err != nil {
+			return nil, fmt.Errorf("failed to authenticate request: %w", err)
+		}
+		if setup != nil {
+			setup(req)
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			cb.record(false)
+			lastErr = fmt.Errorf("request failed: %w", err)
+			if !isRetryableVerb(method) || !isTemporaryNetErr(err) || attempt == maxAttempts-1 {
+				return nil, lastErr
+			}
+			if sleepErr := sleepOrDone(ctx, policy.backoffFor(attempt)); sleepErr != nil {
+				return nil, sleepErr
+			}
+			continue
+		}
+
+		if policy != nil && policy.isRetryableStatus(resp.StatusCode) && isRetryableVerb(method) && attempt < maxAttempts-1 {
+			cb.record(false)
+			wait := policy.backoffFor(attempt)
+			if ra, ok := retryAfter(resp); ok {
+				wait = ra
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			if sleepErr := sleepOrDone(ctx, wait); sleepErr != nil {
+				return nil, sleepErr
+			}
+			continue
+		}
+
+		cb.record(resp.StatusCode < 500)
+		return resp, nil
+	}
+
+	return nil, lastErr
+}
+
+// isRetryableVerb reports whether method is idempotent and safe to auto-retry
+func isRetryableVerb(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodDelete, http.MethodPut, http.MethodHead:
+		return true
+	default:
+		return false
+	}
+}
+
+// hostOf extracts the host component used to key the per-host circuit breaker
+func hostOf(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return u.Host
+}
+
 // setHeaders sets common headers for all requests
-func (c *Client) setHeaders(req *http.Request) {
+func (c *Client) setHeaders(ctx context.Context, req *http.Request) error {
 	if c.apiKey != "" {
 		req.Header.Set("X-API-Key", c.apiKey)
 	}
+	if c.authenticator != nil {
+		if err := c.authenticator.Apply(ctx, req); err != nil {
+			return err
+		}
+	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", "mcp-hangar-operator/1.0")
+	return nil
 }
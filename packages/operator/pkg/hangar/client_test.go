@@ -27,10 +27,11 @@ func TestClient_GetProviderTools_Success(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := NewClient(&Config{
+	client, err := NewClient(&Config{
 		URL:    server.URL,
 		APIKey: "test-api-key",
 	})
+	require.NoError(t, err)
 
 	// Execute
 	tools, err := client.GetProviderTools(context.Background(), "test-provider", "default")
@@ -52,10 +53,11 @@ func TestClient_GetProviderTools_NotFound(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := NewClient(&Config{
+	client, err := NewClient(&Config{
 		URL:    server.URL,
 		APIKey: "test-api-key",
 	})
+	require.NoError(t, err)
 
 	tools, err := client.GetProviderTools(context.Background(), "nonexistent", "default")
 
@@ -70,11 +72,12 @@ func TestClient_GetProviderTools_Timeout(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := NewClient(&Config{
+	client, err := NewClient(&Config{
 		URL:     server.URL,
 		APIKey:  "test-api-key",
 		Timeout: 10 * time.Millisecond,
 	})
+	require.NoError(t, err)
 
 	ctx := context.Background()
 	tools, err := client.GetProviderTools(ctx, "test-provider", "default")
@@ -97,10 +100,11 @@ func TestClient_HealthCheckRemote_Healthy(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := NewClient(&Config{
+	client, err := NewClient(&Config{
 		URL:    server.URL,
 		APIKey: "test-api-key",
 	})
+	require.NoError(t, err)
 
 	healthy, tools, err := client.HealthCheckRemote(context.Background(), "https://api.example.com")
 
@@ -122,10 +126,11 @@ func TestClient_HealthCheckRemote_Unhealthy(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := NewClient(&Config{
+	client, err := NewClient(&Config{
 		URL:    server.URL,
 		APIKey: "test-api-key",
 	})
+	require.NoError(t, err)
 
 	healthy, tools, err := client.HealthCheckRemote(context.Background(), "https://broken.example.com")
 
@@ -155,10 +160,11 @@ func TestClient_RegisterProvider_Success(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := NewClient(&Config{
+	client, err := NewClient(&Config{
 		URL:    server.URL,
 		APIKey: "test-api-key",
 	})
+	require.NoError(t, err)
 
 	req := &RegisterProviderRequest{
 		Name:      "test-provider",
@@ -167,7 +173,7 @@ func TestClient_RegisterProvider_Success(t *testing.T) {
 		Image:     "test:latest",
 	}
 
-	err := client.RegisterProvider(context.Background(), req)
+	err = client.RegisterProvider(context.Background(), req)
 
 	assert.NoError(t, err)
 }
@@ -185,12 +191,13 @@ func TestClient_DeregisterProvider_Success(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := NewClient(&Config{
+	client, err := NewClient(&Config{
 		URL:    server.URL,
 		APIKey: "test-api-key",
 	})
+	require.NoError(t, err)
 
-	err := client.DeregisterProvider(context.Background(), "test-provider", "default")
+	err = client.DeregisterProvider(context.Background(), "test-provider", "default")
 
 	assert.NoError(t, err)
 }
@@ -201,13 +208,14 @@ func TestClient_DeregisterProvider_NotFound(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := NewClient(&Config{
+	client, err := NewClient(&Config{
 		URL:    server.URL,
 		APIKey: "test-api-key",
 	})
+	require.NoError(t, err)
 
 	// Should not error on 404 - provider already gone
-	err := client.DeregisterProvider(context.Background(), "nonexistent", "default")
+	err = client.DeregisterProvider(context.Background(), "nonexistent", "default")
 
 	assert.NoError(t, err)
 }
@@ -221,10 +229,11 @@ func TestClient_ServerError(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := NewClient(&Config{
+	client, err := NewClient(&Config{
 		URL:    server.URL,
 		APIKey: "test-api-key",
 	})
+	require.NoError(t, err)
 
 	tools, err := client.GetProviderTools(context.Background(), "test-provider", "default")
 
@@ -240,10 +249,11 @@ func TestClient_InvalidJSON(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := NewClient(&Config{
+	client, err := NewClient(&Config{
 		URL:    server.URL,
 		APIKey: "test-api-key",
 	})
+	require.NoError(t, err)
 
 	tools, err := client.GetProviderTools(context.Background(), "test-provider", "default")
 
@@ -257,10 +267,11 @@ func TestClient_ContextCancellation(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := NewClient(&Config{
+	client, err := NewClient(&Config{
 		URL:    server.URL,
 		APIKey: "test-api-key",
 	})
+	require.NoError(t, err)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel() // Cancel immediately
@@ -272,11 +283,60 @@ func TestClient_ContextCancellation(t *testing.T) {
 	assert.Contains(t, err.Error(), "context canceled")
 }
 
+func TestClient_DrainProvider_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/providers/default/test-provider/drain", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var body struct {
+			TimeoutSeconds float64 `json:"timeout_seconds"`
+		}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, float64(10), body.TimeoutSeconds)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		URL:    server.URL,
+		APIKey: "test-api-key",
+	})
+	require.NoError(t, err)
+
+	err = client.DrainProvider(context.Background(), "test-provider", "default", 10*time.Second)
+
+	assert.NoError(t, err)
+}
+
+func TestClient_DrainProvider_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{
+			"error": "internal server error",
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		URL:    server.URL,
+		APIKey: "test-api-key",
+	})
+	require.NoError(t, err)
+
+	err = client.DrainProvider(context.Background(), "test-provider", "default", 10*time.Second)
+
+	assert.Error(t, err)
+}
+
 func TestNewClient(t *testing.T) {
-	client := NewClient(&Config{
+	client, err := NewClient(&Config{
 		URL:    "http://localhost:8080",
 		APIKey: "my-api-key",
 	})
+	require.NoError(t, err)
 
 	assert.NotNil(t, client)
 	assert.Equal(t, "http://localhost:8080", client.baseURL)
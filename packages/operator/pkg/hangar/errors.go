@@ -0,0 +1,74 @@
+package hangar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// APIError represents a structured error returned by MCP-Hangar core, decoded
+// from the canonical JSON error envelope: {"error":{"code":"...","message":"...","details":{...}}}
+type APIError struct {
+	Code       string         `json:"code"`
+	Message    string         `json:"message"`
+	HTTPStatus int            `json:"-"`
+	Details    map[string]any `json:"details,omitempty"`
+	RequestID  string         `json:"-"`
+}
+
+func (e *APIError) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("hangar: %s (code=%s, status=%d, request_id=%s)", e.Message, e.Code, e.HTTPStatus, e.RequestID)
+	}
+	return fmt.Sprintf("hangar: %s (code=%s, status=%d)", e.Message, e.Code, e.HTTPStatus)
+}
+
+// Is supports errors.Is by comparing error codes, so callers can match against
+// the sentinel vars below without caring about message text or HTTP status.
+func (e *APIError) Is(target error) bool {
+	var other *APIError
+	if !errors.As(target, &other) {
+		return false
+	}
+	return e.Code == other.Code
+}
+
+// Sentinel errors for common Hangar core failure codes. Compare with errors.Is.
+var (
+	ErrProviderNotFound = &APIError{Code: "provider_not_found", Message: "provider not found"}
+	ErrProviderConflict = &APIError{Code: "provider_conflict", Message: "provider already exists"}
+	ErrUnauthorized     = &APIError{Code: "unauthorized", Message: "unauthorized"}
+	ErrRateLimited      = &APIError{Code: "rate_limited", Message: "rate limited"}
+	ErrValidation       = &APIError{Code: "validation_error", Message: "validation error"}
+)
+
+// errorEnvelope is the canonical JSON error body shape returned by Hangar core
+type errorEnvelope struct {
+	Error struct {
+		Code      string         `json:"code"`
+		Message   string         `json:"message"`
+		Details   map[string]any `json:"details,omitempty"`
+		RequestID string         `json:"request_id,omitempty"`
+	} `json:"error"`
+}
+
+// parseAPIError decodes a non-2xx response body into an *APIError, falling
+// back to a plain status-line error when the body isn't the canonical envelope.
+func parseAPIError(resp *http.Response) error {
+	body, _ := io.ReadAll(resp.Body)
+
+	var env errorEnvelope
+	if err := json.Unmarshal(body, &env); err == nil && env.Error.Code != "" {
+		return &APIError{
+			Code:       env.Error.Code,
+			Message:    env.Error.Message,
+			HTTPStatus: resp.StatusCode,
+			Details:    env.Error.Details,
+			RequestID:  env.Error.RequestID,
+		}
+	}
+
+	return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(body))
+}
@@ -0,0 +1,83 @@
+package hangar
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetProviderTools_ErrorsIsNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]any{
+			"error": map[string]any{
+				"code":    "provider_not_found",
+				"message": "provider default/missing not found",
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{URL: server.URL})
+	require.NoError(t, err)
+
+	_, err = client.GetProviderTools(context.Background(), "missing", "default")
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrProviderNotFound))
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.HTTPStatus)
+}
+
+func TestClient_RegisterProvider_ErrorsIsConflict(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(map[string]any{
+			"error": map[string]any{
+				"code":       "provider_conflict",
+				"message":    "provider already registered",
+				"request_id": "req-123",
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{URL: server.URL})
+	require.NoError(t, err)
+
+	err = client.RegisterProvider(context.Background(), &RegisterProviderRequest{Name: "p", Namespace: "default", Mode: "container"})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrProviderConflict))
+	assert.False(t, errors.Is(err, ErrValidation))
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "req-123", apiErr.RequestID)
+}
+
+func TestClient_FallsBackToTextError_WhenBodyNotJSONEnvelope(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte("boom"))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{URL: server.URL})
+	require.NoError(t, err)
+
+	_, err = client.GetProviderTools(context.Background(), "p", "default")
+
+	require.Error(t, err)
+	var apiErr *APIError
+	assert.False(t, errors.As(err, &apiErr))
+	assert.Contains(t, err.Error(), "boom")
+}
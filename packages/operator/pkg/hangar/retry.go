@@ -0,0 +1,134 @@
+package hangar
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures automatic retry behavior for Client requests
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts (including the first), 0 disables retries
+	MaxAttempts int
+
+	// InitialBackoff is the backoff before the first retry
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the backoff between retries
+	MaxBackoff time.Duration
+
+	// Multiplier scales the backoff after each attempt
+	Multiplier float64
+
+	// JitterFraction adds +/- jitter as a fraction of the computed backoff (0-1)
+	JitterFraction float64
+
+	// RetryableStatus lists HTTP status codes that should trigger a retry
+	RetryableStatus []int
+}
+
+// DefaultRetryPolicy returns sane retry defaults for talking to Hangar core
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:     3,
+		InitialBackoff:  200 * time.Millisecond,
+		MaxBackoff:      5 * time.Second,
+		Multiplier:      2.0,
+		JitterFraction:  0.2,
+		RetryableStatus: []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+	}
+}
+
+func (p *RetryPolicy) isRetryableStatus(status int) bool {
+	if status >= 500 && status != http.StatusNotImplemented {
+		return true
+	}
+	for _, s := range p.RetryableStatus {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// backoffFor returns the backoff duration before attempt (0-indexed retry count)
+func (p *RetryPolicy) backoffFor(retry int) time.Duration {
+	backoff := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(retry))
+	if max := float64(p.MaxBackoff); max > 0 && backoff > max {
+		backoff = max
+	}
+	if p.JitterFraction > 0 {
+		jitter := backoff * p.JitterFraction
+		backoff += (rand.Float64()*2 - 1) * jitter
+	}
+	if backoff < 0 {
+		backoff = 0
+	}
+	return time.Duration(backoff)
+}
+
+func isTemporaryNetErr(err error) bool {
+	var netErr net.Error
+	if ok := asNetError(err, &netErr); ok {
+		return netErr.Timeout() || isTemporary(netErr)
+	}
+	return false
+}
+
+// isTemporary calls the now-deprecated Temporary() method where still implemented
+func isTemporary(err net.Error) bool {
+	type temporary interface{ Temporary() bool }
+	if t, ok := err.(temporary); ok {
+		return t.Temporary()
+	}
+	return false
+}
+
+func asNetError(err error, target *net.Error) bool {
+	for err != nil {
+		if netErr, ok := err.(net.Error); ok {
+			*target = netErr
+			return true
+		}
+		unwrapper, ok := err.(interface{ Unwrap() error })
+		if !ok {
+			return false
+		}
+		err = unwrapper.Unwrap()
+	}
+	return false
+}
+
+// retryAfter parses the Retry-After header, returning (duration, true) if present
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t), true
+	}
+	return 0, false
+}
+
+// sleepOrDone waits for the given duration or returns ctx.Err() if ctx is cancelled first
+func sleepOrDone(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
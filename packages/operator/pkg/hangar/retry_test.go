@@ -0,0 +1,157 @@
+package hangar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetProviderTools_RetriesOn503(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"tools":["tool1"]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		URL: server.URL,
+		RetryPolicy: &RetryPolicy{
+			MaxAttempts:    5,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     5 * time.Millisecond,
+			Multiplier:     2,
+		},
+	})
+	require.NoError(t, err)
+
+	tools, err := client.GetProviderTools(context.Background(), "test-provider", "default")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tool1"}, tools)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestClient_GetProviderTools_GivesUpAfterMaxAttempts(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		URL: server.URL,
+		RetryPolicy: &RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     5 * time.Millisecond,
+			Multiplier:     2,
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = client.GetProviderTools(context.Background(), "test-provider", "default")
+
+	assert.Error(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestClient_RegisterProvider_DoesNotRetryOnPost(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		URL: server.URL,
+		RetryPolicy: &RetryPolicy{
+			MaxAttempts:    5,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     5 * time.Millisecond,
+			Multiplier:     2,
+		},
+	})
+	require.NoError(t, err)
+
+	err = client.RegisterProvider(context.Background(), &RegisterProviderRequest{Name: "p", Namespace: "default", Mode: "container"})
+
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+}
+
+func TestCircuitBreaker_OpensAfterFailures(t *testing.T) {
+	cb := newCircuitBreaker()
+	cb.minRequests = 4
+	cb.failureThreshold = 50
+
+	for i := 0; i < 4; i++ {
+		assert.True(t, cb.allow())
+		cb.record(false)
+	}
+
+	assert.False(t, cb.allow())
+}
+
+func TestCircuitBreaker_HalfOpenRecoversOnSuccess(t *testing.T) {
+	cb := newCircuitBreaker()
+	cb.minRequests = 2
+	cb.failureThreshold = 50
+	cb.resetTimeout = time.Millisecond
+
+	cb.allow()
+	cb.record(false)
+	cb.allow()
+	cb.record(false)
+	require.False(t, cb.allow())
+
+	time.Sleep(5 * time.Millisecond)
+
+	require.True(t, cb.allow())
+	cb.record(true)
+
+	assert.Equal(t, circuitClosed, cb.state)
+}
+
+func TestClient_CircuitOpen_FailsFast(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		URL: server.URL,
+		RetryPolicy: &RetryPolicy{
+			MaxAttempts:    1,
+			InitialBackoff: time.Millisecond,
+		},
+	})
+	require.NoError(t, err)
+
+	// Force the circuit open directly rather than driving many requests through it.
+	cb := client.circuits.forHost(hostOf(server.URL))
+	cb.minRequests = 1
+	cb.failureThreshold = 1
+	cb.allow()
+	cb.record(false)
+	require.False(t, cb.allow())
+
+	_, err = client.GetProviderTools(context.Background(), "test-provider", "default")
+
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&attempts))
+}
@@ -0,0 +1,218 @@
+package hangar
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/mapyr/mcp-hangar/operator/pkg/hangar"
+
+// operationKey identifies the logical Client method (e.g. "GetProviderTools")
+// behind a request, for span names and metric labels.
+type operationKey struct{}
+
+// withOperation tags ctx with the name of the Client method making the
+// request and, when applicable, the provider it targets, so the
+// instrumented transport can label spans, metrics, and log lines.
+func withOperation(ctx context.Context, method, provider, namespace string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operationInfo{method: method, provider: provider, namespace: namespace})
+}
+
+// telemetry holds the instruments built from Config's observability fields.
+// A nil *telemetry (or nil field within it) means that signal is disabled.
+type telemetry struct {
+	tracer oteltrace.Tracer
+
+	requestsTotal    otelmetric.Int64Counter
+	requestDuration  otelmetric.Float64Histogram
+	inflightRequests otelmetric.Int64UpDownCounter
+
+	logger        *slog.Logger
+	redactHeaders map[string]struct{}
+}
+
+// newTelemetry builds a *telemetry from config, or returns (nil, nil) if no
+// observability fields were set.
+func newTelemetry(config *Config) (*telemetry, error) {
+	if config.TracerProvider == nil && config.MeterProvider == nil && config.Logger == nil {
+		return nil, nil
+	}
+
+	t := &telemetry{}
+
+	if config.TracerProvider != nil {
+		t.tracer = config.TracerProvider.Tracer(instrumentationName)
+	}
+
+	if config.MeterProvider != nil {
+		meter := config.MeterProvider.Meter(instrumentationName)
+
+		requestsTotal, err := meter.Int64Counter(
+			"hangar_client_requests_total",
+			otelmetric.WithDescription("Total Hangar client requests by method and status"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("hangar: failed to create requests_total counter: %w", err)
+		}
+
+		requestDuration, err := meter.Float64Histogram(
+			"hangar_client_request_duration_seconds",
+			otelmetric.WithDescription("Hangar client request duration in seconds, by method"),
+			otelmetric.WithUnit("s"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("hangar: failed to create request_duration histogram: %w", err)
+		}
+
+		inflightRequests, err := meter.Int64UpDownCounter(
+			"hangar_client_inflight_requests",
+			otelmetric.WithDescription("In-flight Hangar client requests, by method"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("hangar: failed to create inflight_requests gauge: %w", err)
+		}
+
+		t.requestsTotal = requestsTotal
+		t.requestDuration = requestDuration
+		t.inflightRequests = inflightRequests
+	}
+
+	if config.Logger != nil {
+		t.logger = config.Logger
+		t.redactHeaders = buildRedactSet(config.RedactHeaders)
+	}
+
+	return t, nil
+}
+
+func buildRedactSet(extra []string) map[string]struct{} {
+	set := map[string]struct{}{
+		"x-api-key":     {},
+		"authorization": {},
+	}
+	for _, h := range extra {
+		set[strings.ToLower(h)] = struct{}{}
+	}
+	return set
+}
+
+// operationInfo carries the logical method name for a request.
+type operationInfo struct {
+	method    string
+	provider  string
+	namespace string
+}
+
+// instrumentedTransport wraps an http.RoundTripper with tracing, metrics,
+// and structured logging, in the style of otelhttp's transport.
+type instrumentedTransport struct {
+	next      http.RoundTripper
+	telemetry *telemetry
+}
+
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	op, _ := ctx.Value(operationKey{}).(operationInfo)
+	method := op.method
+	if method == "" {
+		method = req.Method
+	}
+
+	start := time.Now()
+
+	var span oteltrace.Span
+	if t.telemetry.tracer != nil {
+		ctx, span = t.telemetry.tracer.Start(ctx, fmt.Sprintf("Hangar.%s", method), oteltrace.WithAttributes(
+			attribute.String("hangar.provider", op.provider),
+			attribute.String("hangar.namespace", op.namespace),
+		))
+		defer span.End()
+
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+		req = req.WithContext(ctx)
+	}
+
+	attrs := otelmetric.WithAttributes(attribute.String("method", method))
+	if t.telemetry.inflightRequests != nil {
+		t.telemetry.inflightRequests.Add(ctx, 1, attrs)
+		defer t.telemetry.inflightRequests.Add(ctx, -1, attrs)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	duration := time.Since(start)
+
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+	}
+
+	if span != nil {
+		span.SetAttributes(attribute.Int("http.status_code", status))
+		if err != nil {
+			span.RecordError(err)
+		}
+	}
+
+	if t.telemetry.requestsTotal != nil {
+		t.telemetry.requestsTotal.Add(ctx, 1, otelmetric.WithAttributes(
+			attribute.String("method", method),
+			attribute.Int("status", status),
+		))
+	}
+	if t.telemetry.requestDuration != nil {
+		t.telemetry.requestDuration.Record(ctx, duration.Seconds(), attrs)
+	}
+
+	if t.telemetry.logger != nil {
+		t.logRequest(req, status, duration, err)
+	}
+
+	return resp, err
+}
+
+func (t *instrumentedTransport) logRequest(req *http.Request, status int, duration time.Duration, reqErr error) {
+	attrs := []any{
+		"method", req.Method,
+		"path", req.URL.Path,
+		"status", status,
+		"duration_ms", duration.Milliseconds(),
+	}
+	if reqErr != nil {
+		attrs = append(attrs, "error", reqErr)
+	}
+
+	logger := t.telemetry.logger
+	if logger.Enabled(req.Context(), slog.LevelDebug) {
+		attrs = append(attrs, "headers", t.telemetry.redactedHeaders(req.Header))
+	}
+
+	if reqErr != nil || status >= 500 {
+		logger.Error("hangar client request", attrs...)
+	} else {
+		logger.Info("hangar client request", attrs...)
+	}
+}
+
+// redactedHeaders returns a copy of h with any header in redactHeaders
+// replaced by "REDACTED", so debug logs never leak credentials.
+func (t *telemetry) redactedHeaders(h http.Header) map[string]string {
+	out := make(map[string]string, len(h))
+	for name, values := range h {
+		if _, redact := t.redactHeaders[strings.ToLower(name)]; redact {
+			out[name] = "REDACTED"
+			continue
+		}
+		out[name] = strings.Join(values, ",")
+	}
+	return out
+}
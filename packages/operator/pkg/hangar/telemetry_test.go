@@ -0,0 +1,107 @@
+package hangar
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestClient_Tracing_CreatesSpanPerRequest(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"tools":["t1"]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{URL: server.URL, TracerProvider: tp})
+	require.NoError(t, err)
+
+	_, err = client.GetProviderTools(context.Background(), "my-provider", "default")
+	require.NoError(t, err)
+	require.NoError(t, tp.Shutdown(context.Background()))
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "Hangar.GetProviderTools", spans[0].Name)
+
+	attrs := map[string]string{}
+	for _, a := range spans[0].Attributes {
+		attrs[string(a.Key)] = a.Value.Emit()
+	}
+	assert.Equal(t, "my-provider", attrs["hangar.provider"])
+	assert.Equal(t, "default", attrs["hangar.namespace"])
+	assert.Equal(t, "200", attrs["http.status_code"])
+}
+
+func TestClient_Metrics_RecordsRequestCountAndDuration(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"tools":[]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{URL: server.URL, MeterProvider: mp})
+	require.NoError(t, err)
+
+	_, err = client.GetProviderTools(context.Background(), "p", "default")
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	foundCounter := false
+	foundHistogram := false
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch m.Name {
+			case "hangar_client_requests_total":
+				foundCounter = true
+			case "hangar_client_request_duration_seconds":
+				foundHistogram = true
+			}
+		}
+	}
+	assert.True(t, foundCounter, "expected hangar_client_requests_total to be recorded")
+	assert.True(t, foundHistogram, "expected hangar_client_request_duration_seconds to be recorded")
+}
+
+func TestClient_Logger_RedactsAPIKeyAndAuthorization(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"tools":[]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		URL:    server.URL,
+		APIKey: "super-secret",
+		Logger: logger,
+	})
+	require.NoError(t, err)
+
+	_, err = client.GetProviderTools(context.Background(), "p", "default")
+	require.NoError(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, "hangar client request")
+	assert.NotContains(t, out, "super-secret")
+}
@@ -0,0 +1,74 @@
+package hangar
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+)
+
+// buildTransport constructs an *http.Transport from the Config's TLS settings.
+// It returns (nil, nil) when no TLS customization was requested, letting the
+// caller fall back to http.DefaultTransport's behavior.
+func buildTransport(config *Config) (*http.Transport, error) {
+	if err := validateAuthConfig(config); err != nil {
+		return nil, err
+	}
+
+	if config.TLSConfig == nil && config.CACertFile == "" && config.ClientCertFile == "" && config.ClientKeyFile == "" {
+		return nil, nil
+	}
+
+	tlsConfig := config.TLSConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	} else {
+		tlsConfig = tlsConfig.Clone()
+	}
+
+	if config.CACertFile != "" {
+		pool, err := loadCAPool(config.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCertFile != "" || config.ClientKeyFile != "" {
+		if config.ClientCertFile == "" || config.ClientKeyFile == "" {
+			return nil, fmt.Errorf("hangar: ClientCertFile and ClientKeyFile must both be set")
+		}
+		cert, err := tls.LoadX509KeyPair(config.ClientCertFile, config.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("hangar: failed to load client cert/key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return &http.Transport{TLSClientConfig: tlsConfig}, nil
+}
+
+func loadCAPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("hangar: failed to read CA cert file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("hangar: no valid certificates found in %s", path)
+	}
+	return pool, nil
+}
+
+// validateAuthConfig rejects Config combinations that mix incompatible auth modes.
+func validateAuthConfig(config *Config) error {
+	_, isMTLS := config.Authenticator.(MutualTLS)
+	if isMTLS && (config.ClientCertFile == "" || config.ClientKeyFile == "") {
+		return fmt.Errorf("hangar: MutualTLS authenticator requires ClientCertFile and ClientKeyFile")
+	}
+	if config.APIKey != "" && config.Authenticator != nil {
+		return fmt.Errorf("hangar: Config.APIKey and Config.Authenticator are mutually exclusive")
+	}
+	return nil
+}
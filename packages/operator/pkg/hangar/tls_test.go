@@ -0,0 +1,226 @@
+package hangar
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCA is a minimal self-signed CA used to issue a server certificate and a
+// client certificate for mTLS tests.
+type testCA struct {
+	certPEM []byte
+	key     *ecdsa.PrivateKey
+	cert    *x509.Certificate
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		key:     key,
+		cert:    cert,
+	}
+}
+
+func (ca *testCA) issue(t *testing.T, cn string, isServer bool) tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	if isServer {
+		tmpl.DNSNames = []string{"127.0.0.1"}
+		tmpl.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	} else {
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	pair, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	return pair
+}
+
+func writePEM(t *testing.T, dir, name string, cert tls.Certificate) (certPath, keyPath string) {
+	t.Helper()
+
+	certPath = filepath.Join(dir, name+".crt")
+	keyPath = filepath.Join(dir, name+".key")
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]})
+	require.NoError(t, os.WriteFile(certPath, certPEM, 0o600))
+
+	keyDER, err := x509.MarshalECPrivateKey(cert.PrivateKey.(*ecdsa.PrivateKey))
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	require.NoError(t, os.WriteFile(keyPath, keyPEM, 0o600))
+
+	return certPath, keyPath
+}
+
+func TestClient_MutualTLS_Success(t *testing.T) {
+	ca := newTestCA(t)
+	serverCert := ca.issue(t, "hangar-server", true)
+	clientCert := ca.issue(t, "hangar-client", false)
+
+	dir := t.TempDir()
+	caPath := filepath.Join(dir, "ca.crt")
+	require.NoError(t, os.WriteFile(caPath, ca.certPEM, 0o600))
+	clientCertPath, clientKeyPath := writePEM(t, dir, "client", clientCert)
+
+	clientPool := x509.NewCertPool()
+	clientPool.AddCert(ca.cert)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NotEmpty(t, r.TLS.PeerCertificates)
+		assert.Equal(t, "hangar-client", r.TLS.PeerCertificates[0].Subject.CommonName)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"healthy":true,"tools":[]}`))
+	}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientPool,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		URL:            server.URL,
+		CACertFile:     caPath,
+		ClientCertFile: clientCertPath,
+		ClientKeyFile:  clientKeyPath,
+		Authenticator:  MutualTLS{},
+	})
+	require.NoError(t, err)
+
+	healthy, _, err := client.HealthCheckRemote(context.Background(), "irrelevant")
+	require.NoError(t, err)
+	assert.True(t, healthy)
+}
+
+func TestClient_MutualTLS_RejectsUntrustedServer(t *testing.T) {
+	ca := newTestCA(t)
+	otherCA := newTestCA(t)
+	serverCert := ca.issue(t, "hangar-server", true)
+
+	dir := t.TempDir()
+	caPath := filepath.Join(dir, "ca.crt")
+	require.NoError(t, os.WriteFile(caPath, otherCA.certPEM, 0o600))
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{serverCert}}
+	server.StartTLS()
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		URL:        server.URL,
+		CACertFile: caPath,
+		RetryPolicy: &RetryPolicy{
+			MaxAttempts: 1,
+		},
+	})
+	require.NoError(t, err)
+
+	_, _, err = client.HealthCheckRemote(context.Background(), "irrelevant")
+	require.Error(t, err)
+}
+
+func TestBuildTransport_RejectsMutualTLSWithoutCert(t *testing.T) {
+	_, err := NewClient(&Config{
+		URL:           "https://example.invalid",
+		Authenticator: MutualTLS{},
+	})
+	require.Error(t, err)
+}
+
+func TestBuildTransport_RejectsAPIKeyAndAuthenticatorTogether(t *testing.T) {
+	_, err := NewClient(&Config{
+		URL:           "https://example.invalid",
+		APIKey:        "key",
+		Authenticator: &BearerToken{Token: "tok"},
+	})
+	require.Error(t, err)
+}
+
+func TestTokenSource_RefreshesFromFile(t *testing.T) {
+	dir := t.TempDir()
+	tokenPath := filepath.Join(dir, "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("token-v1\n"), 0o600))
+
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"tools":[]}`))
+	}))
+	defer server.Close()
+
+	src := &TokenSource{TokenFile: tokenPath, RefreshInterval: time.Millisecond}
+	client, err := NewClient(&Config{URL: server.URL, Authenticator: src})
+	require.NoError(t, err)
+
+	_, err = client.GetProviderTools(context.Background(), "p", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-v1", gotAuth)
+
+	require.NoError(t, os.WriteFile(tokenPath, []byte("token-v2\n"), 0o600))
+	time.Sleep(5 * time.Millisecond)
+
+	_, err = client.GetProviderTools(context.Background(), "p", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-v2", gotAuth)
+}
@@ -0,0 +1,255 @@
+package hangar
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ProviderEvent is a single state-transition notification delivered by
+// WatchProviders.
+type ProviderEvent struct {
+	// Type is one of "added", "modified", "deleted", "health", or "error" for
+	// the final sentinel event sent when the watch fails permanently.
+	Type string
+
+	Provider ProviderInfo
+
+	// ResourceVersion identifies this event for resume via Last-Event-ID.
+	ResourceVersion string
+
+	// Err is set only on the final sentinel event of type "error".
+	Err error
+}
+
+// HealthEvent identifies an MCPProvider that WatchHealth observed a health
+// transition, tool list change, or deregistration for, so a caller can
+// enqueue a reconcile for it without waiting on the periodic requeue.
+type HealthEvent struct {
+	Name      string
+	Namespace string
+}
+
+// WatchOptions configures WatchProviders.
+type WatchOptions struct {
+	// ResourceVersion, if set, resumes the watch after this event via the
+	// Last-Event-ID header.
+	ResourceVersion string
+}
+
+// WatchProviders opens a long-lived Server-Sent Events stream of provider
+// state transitions in namespace and delivers them on the returned channel.
+// The reader auto-reconnects with backoff on transient failures, resuming
+// from the last received event via Last-Event-ID. The channel is closed
+// when ctx is done, or after delivering a final Type: "error" event when
+// the stream fails permanently.
+func (c *Client) WatchProviders(ctx context.Context, namespace string, opts WatchOptions) (<-chan ProviderEvent, error) {
+	events := make(chan ProviderEvent)
+
+	policy := c.retryPolicy
+	if policy == nil {
+		policy = DefaultRetryPolicy()
+	}
+
+	go c.watchLoop(ctx, namespace, opts.ResourceVersion, policy, events)
+
+	return events, nil
+}
+
+// watchLoop repeatedly opens an SSE connection, reconnecting with backoff
+// whenever the connection drops (including a clean server-side close, which
+// is a normal part of SSE keepalive cycling). It only returns once ctx is
+// done or too many consecutive reconnects have failed in a row.
+func (c *Client) watchLoop(ctx context.Context, namespace, lastEventID string, policy *RetryPolicy, events chan<- ProviderEvent) {
+	defer close(events)
+
+	attempt := 0
+	for {
+		delivered := 0
+		err := c.watchOnce(ctx, namespace, lastEventID, &lastEventID, events, &delivered)
+		if ctx.Err() != nil {
+			return
+		}
+		if delivered > 0 {
+			attempt = 0
+		}
+
+		if attempt >= policy.MaxAttempts {
+			if err == nil {
+				err = fmt.Errorf("watch connection closed repeatedly with no events delivered")
+			}
+			select {
+			case events <- ProviderEvent{Type: "error", Err: fmt.Errorf("watch failed permanently: %w", err)}:
+			case <-ctx.Done():
+			}
+			return
+		}
+
+		if sleepErr := sleepOrDone(ctx, policy.backoffFor(attempt)); sleepErr != nil {
+			return
+		}
+		attempt++
+	}
+}
+
+// watchOnce opens a single SSE connection and streams events until the
+// connection ends or ctx is cancelled, updating *lastEventID as events arrive
+// so a subsequent reconnect can resume from where this one left off.
+func (c *Client) watchOnce(ctx context.Context, namespace, lastEventID string, lastEventIDOut *string, events chan<- ProviderEvent, delivered *int) error {
+	ctx = withOperation(ctx, "WatchProviders", "", namespace)
+	url := fmt.Sprintf("%s/api/v1/providers/%s/watch", c.baseURL, namespace)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create watch request: %w", err)
+	}
+	if err := c.setHeaders(ctx, req); err != nil {
+		return fmt.Errorf("failed to authenticate watch request: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("watch request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return parseAPIError(resp)
+	}
+
+	frames := make(chan sseFrame)
+	scanErrCh := make(chan error, 1)
+	go func() {
+		scanErrCh <- scanSSE(resp.Body, frames)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case frame, ok := <-frames:
+			if !ok {
+				return <-scanErrCh
+			}
+			event, ok := frame.toProviderEvent()
+			if !ok {
+				continue
+			}
+			if frame.id != "" {
+				*lastEventIDOut = frame.id
+			}
+			select {
+			case events <- event:
+				*delivered++
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+}
+
+// WatchHealth narrows WatchProviders down to the events a reconciler cares
+// about - a health transition, a tool list change, or a deregistration -
+// reporting each as the MCPProvider it concerns. "added" events are dropped,
+// since a newly created provider already gets its own reconcile. The
+// returned channel closes when ctx is done or the underlying watch fails
+// permanently; WatchProviders' own reconnect/backoff covers transient
+// disconnects, so callers only need to fall back to periodic reconciliation
+// once this channel closes for good.
+func (c *Client) WatchHealth(ctx context.Context, namespace string) (<-chan HealthEvent, error) {
+	providerEvents, err := c.WatchProviders(ctx, namespace, WatchOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	health := make(chan HealthEvent)
+	go func() {
+		defer close(health)
+		for providerEvent := range providerEvents {
+			switch providerEvent.Type {
+			case "health", "modified", "deleted":
+				select {
+				case health <- HealthEvent{Name: providerEvent.Provider.Name, Namespace: providerEvent.Provider.Namespace}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return health, nil
+}
+
+// sseFrame is a single `event:`/`data:`/`id:` block as defined by the SSE spec.
+type sseFrame struct {
+	event string
+	data  string
+	id    string
+}
+
+func (f sseFrame) toProviderEvent() (ProviderEvent, bool) {
+	if f.data == "" {
+		return ProviderEvent{}, false
+	}
+
+	var info ProviderInfo
+	if err := json.Unmarshal([]byte(f.data), &info); err != nil {
+		return ProviderEvent{}, false
+	}
+
+	eventType := f.event
+	if eventType == "" {
+		eventType = "modified"
+	}
+
+	return ProviderEvent{
+		Type:            eventType,
+		Provider:        info,
+		ResourceVersion: f.id,
+	}, true
+}
+
+// scanSSE reads r as a stream of SSE frames separated by blank lines,
+// sending each complete frame on frames. It returns when r is exhausted or
+// an error occurs reading it.
+func scanSSE(r io.Reader, frames chan<- sseFrame) error {
+	defer close(frames)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var current sseFrame
+	var dataLines []string
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if line == "" {
+			if len(dataLines) > 0 {
+				current.data = strings.Join(dataLines, "\n")
+				frames <- current
+			}
+			current = sseFrame{}
+			dataLines = nil
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			current.event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			dataLines = append(dataLines, strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+		case strings.HasPrefix(line, "id:"):
+			current.id = strings.TrimSpace(strings.TrimPrefix(line, "id:"))
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,156 @@
+package hangar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_WatchProviders_DeliversEventsInOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		flusher := w.(http.Flusher)
+
+		fmt.Fprintf(w, "event: added\nid: 1\ndata: {\"name\":\"a\",\"state\":\"starting\"}\n\n")
+		flusher.Flush()
+		fmt.Fprintf(w, "event: modified\nid: 2\ndata: {\"name\":\"a\",\"state\":\"running\"}\n\n")
+		flusher.Flush()
+		fmt.Fprintf(w, "event: deleted\nid: 3\ndata: {\"name\":\"a\",\"state\":\"stopped\"}\n\n")
+		flusher.Flush()
+
+		// Keep the connection open, as a real watch endpoint would, so the
+		// client's own cancellation is what ends the stream rather than a
+		// server-side EOF racing with it.
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{URL: server.URL})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	ch, err := client.WatchProviders(ctx, "default", WatchOptions{})
+	require.NoError(t, err)
+
+	var got []ProviderEvent
+	for ev := range ch {
+		got = append(got, ev)
+		if len(got) == 3 {
+			cancel()
+		}
+	}
+
+	require.Len(t, got, 3)
+	assert.Equal(t, "added", got[0].Type)
+	assert.Equal(t, "1", got[0].ResourceVersion)
+	assert.Equal(t, "modified", got[1].Type)
+	assert.Equal(t, "running", got[1].Provider.State)
+	assert.Equal(t, "deleted", got[2].Type)
+	assert.Equal(t, "3", got[2].ResourceVersion)
+}
+
+func TestClient_WatchProviders_ReconnectResendsLastEventID(t *testing.T) {
+	var connections int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&connections, 1)
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		flusher := w.(http.Flusher)
+
+		if n == 1 {
+			assert.Empty(t, r.Header.Get("Last-Event-ID"))
+			fmt.Fprintf(w, "event: added\nid: 1\ndata: {\"name\":\"a\",\"state\":\"starting\"}\n\n")
+			flusher.Flush()
+			return
+		}
+
+		assert.Equal(t, "1", r.Header.Get("Last-Event-ID"))
+		fmt.Fprintf(w, "event: modified\nid: 2\ndata: {\"name\":\"a\",\"state\":\"running\"}\n\n")
+		flusher.Flush()
+
+		// Hold this connection open so the test's own cancellation is what
+		// ends the stream, rather than a further reconnect racing with it.
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		URL: server.URL,
+		RetryPolicy: &RetryPolicy{
+			MaxAttempts:    5,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     5 * time.Millisecond,
+			Multiplier:     2,
+		},
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	ch, err := client.WatchProviders(ctx, "default", WatchOptions{})
+	require.NoError(t, err)
+
+	var got []ProviderEvent
+	for ev := range ch {
+		got = append(got, ev)
+		if len(got) == 2 {
+			cancel()
+		}
+	}
+
+	require.Len(t, got, 2)
+	assert.Equal(t, "added", got[0].Type)
+	assert.Equal(t, "modified", got[1].Type)
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&connections), int32(2))
+}
+
+func TestClient_WatchHealth_FiltersOutAddedEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		flusher := w.(http.Flusher)
+
+		fmt.Fprintf(w, "event: added\nid: 1\ndata: {\"name\":\"a\",\"namespace\":\"default\"}\n\n")
+		flusher.Flush()
+		fmt.Fprintf(w, "event: health\nid: 2\ndata: {\"name\":\"a\",\"namespace\":\"default\",\"state\":\"degraded\"}\n\n")
+		flusher.Flush()
+		fmt.Fprintf(w, "event: deleted\nid: 3\ndata: {\"name\":\"a\",\"namespace\":\"default\"}\n\n")
+		flusher.Flush()
+
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{URL: server.URL})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	ch, err := client.WatchHealth(ctx, "default")
+	require.NoError(t, err)
+
+	var got []HealthEvent
+	for ev := range ch {
+		got = append(got, ev)
+		if len(got) == 2 {
+			cancel()
+		}
+	}
+
+	require.Len(t, got, 2)
+	assert.Equal(t, HealthEvent{Name: "a", Namespace: "default"}, got[0])
+	assert.Equal(t, HealthEvent{Name: "a", Namespace: "default"}, got[1])
+}
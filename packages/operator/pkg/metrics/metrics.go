@@ -63,6 +63,20 @@ var (
 		[]string{"namespace", "name"},
 	)
 
+	// ProviderCircuitState tracks each provider's circuit breaker state as
+	// a single gauge (0 = closed, 1 = half-open, 2 = open), for dashboards
+	// and alerts that want one time series rather than the per-state
+	// labels ProviderState exposes.
+	ProviderCircuitState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mcp",
+			Subsystem: "operator",
+			Name:      "provider_circuit_state",
+			Help:      "Circuit breaker state of providers (0=closed, 1=half-open, 2=open)",
+		},
+		[]string{"namespace", "name"},
+	)
+
 	// ProviderRestarts tracks provider restarts
 	ProviderRestarts = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -141,6 +155,134 @@ var (
 		},
 		[]string{"operation"},
 	)
+
+	// GroupCircuitState tracks a group's circuit breaker state as a gauge
+	// per possible state, set to 1 for the current state and 0 for the
+	// others, the same one-hot shape ProviderState uses.
+	//
+	// EXPERIMENTAL (unwired): no reconciler calls pkg/group.Tracker yet, so
+	// this series is never emitted outside unit tests.
+	GroupCircuitState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mcp",
+			Subsystem: "operator",
+			Name:      "group_circuit_state",
+			Help:      "Circuit breaker state of a provider group (1 = in this state). EXPERIMENTAL: unwired, not yet emitted by any reconciler.",
+		},
+		[]string{"namespace", "name", "state"},
+	)
+
+	// ProviderEjectionsTotal counts outlier-detection ejections of group
+	// members by reason.
+	//
+	// EXPERIMENTAL (unwired): no reconciler calls pkg/group.Tracker yet, so
+	// this series is never emitted outside unit tests.
+	ProviderEjectionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mcp",
+			Subsystem: "operator",
+			Name:      "provider_ejections_total",
+			Help:      "Total outlier-detection ejections of group members by reason. EXPERIMENTAL: unwired, not yet emitted by any reconciler.",
+		},
+		[]string{"namespace", "name", "reason"},
+	)
+
+	// GroupCircuitTransitionsTotal counts group circuit breaker state
+	// transitions, so operators can alert on flapping.
+	//
+	// EXPERIMENTAL (unwired): no reconciler calls pkg/group.Tracker yet, so
+	// this series is never emitted outside unit tests.
+	GroupCircuitTransitionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mcp",
+			Subsystem: "operator",
+			Name:      "group_circuit_transitions_total",
+			Help:      "Total group circuit breaker state transitions. EXPERIMENTAL: unwired, not yet emitted by any reconciler.",
+		},
+		[]string{"namespace", "name", "from", "to"},
+	)
+
+	// GroupP2CPicksTotal counts WeightedLeastRequest's Power-of-Two-Choices
+	// picks by outcome, so operators can see routing volume and how often
+	// picks fail (e.g. no ready candidates).
+	//
+	// EXPERIMENTAL (unwired): no reconciler calls pkg/group.PickP2C yet, so
+	// this series is never emitted outside unit tests.
+	GroupP2CPicksTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mcp",
+			Subsystem: "operator",
+			Name:      "group_p2c_picks_total",
+			Help:      "Total WeightedLeastRequest P2C picks by outcome. EXPERIMENTAL: unwired, not yet emitted by any reconciler.",
+		},
+		[]string{"namespace", "name", "outcome"},
+	)
+
+	// ProviderRTT tracks AdaptiveConcurrencyLimiter's observed per-call RTT.
+	//
+	// EXPERIMENTAL (unwired): no group router calls pkg/provider's
+	// Gradient2 limiter yet, so this series is never emitted outside unit
+	// tests.
+	ProviderRTT = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "mcp",
+			Subsystem: "operator",
+			Name:      "provider_rtt_seconds",
+			Help:      "Observed provider call RTT in seconds, as fed to AdaptiveConcurrencyLimiter. EXPERIMENTAL: unwired, not yet emitted by any reconciler.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 15),
+		},
+		[]string{"namespace", "name"},
+	)
+
+	// ProviderConcurrencyLimit tracks AdaptiveConcurrencyLimiter's current
+	// limit per provider.
+	//
+	// EXPERIMENTAL (unwired): no group router calls pkg/provider's
+	// Gradient2 limiter yet, so this series is never emitted outside unit
+	// tests.
+	ProviderConcurrencyLimit = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mcp",
+			Subsystem: "operator",
+			Name:      "provider_concurrency_limit",
+			Help:      "Current AdaptiveConcurrencyLimiter limit by provider. EXPERIMENTAL: unwired, not yet emitted by any reconciler.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	// ProviderConcurrencyInFlight tracks AdaptiveConcurrencyLimiter's
+	// current in-flight count per provider.
+	//
+	// EXPERIMENTAL (unwired): no group router calls pkg/provider's
+	// Gradient2 limiter yet, so this series is never emitted outside unit
+	// tests.
+	ProviderConcurrencyInFlight = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mcp",
+			Subsystem: "operator",
+			Name:      "provider_concurrency_in_flight",
+			Help:      "Current AdaptiveConcurrencyLimiter in-flight count by provider. EXPERIMENTAL: unwired, not yet emitted by any reconciler.",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	// FeatureGateEnabled tracks, per named featuregate.Gate, whether it is
+	// currently enabled (1) or disabled (0) in the operator's active gate
+	// set, mirroring the /featuregates debug endpoint for dashboards/alerts.
+	// This is set at manager start from real --feature-gates state, unlike
+	// the EXPERIMENTAL metrics above - but no MCPDiscoverySource
+	// reconciler yet checks a gate before acting on the backend it
+	// guards, so enabling one here doesn't unblock anything yet; see
+	// featuregate.Snapshot.ConsumedByReconciler.
+	FeatureGateEnabled = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mcp",
+			Subsystem: "operator",
+			Name:      "feature_gate_enabled",
+			Help:      "Whether a named feature gate is enabled (1) or disabled (0) in the configured gate set. Enabling a gate here does not yet unblock its discovery backend - no reconciler checks it.",
+		},
+		[]string{"gate", "level"},
+	)
 )
 
 func init() {
@@ -149,6 +291,7 @@ func init() {
 		ReconcileTotal,
 		ReconcileDuration,
 		ProviderState,
+		ProviderCircuitState,
 		ProviderToolsCount,
 		ProviderHealthCheckFailures,
 		ProviderRestarts,
@@ -158,13 +301,30 @@ func init() {
 		DiscoverySyncDuration,
 		HangarClientErrors,
 		HangarClientLatency,
+		GroupCircuitState,
+		ProviderEjectionsTotal,
+		GroupCircuitTransitionsTotal,
+		GroupP2CPicksTotal,
+		ProviderRTT,
+		ProviderConcurrencyLimit,
+		ProviderConcurrencyInFlight,
+		FeatureGateEnabled,
 	)
 }
 
+// SetFeatureGateEnabled records a feature gate's current enabled state.
+func SetFeatureGateEnabled(gate, level string, enabled bool) {
+	val := float64(0)
+	if enabled {
+		val = 1
+	}
+	FeatureGateEnabled.WithLabelValues(gate, level).Set(val)
+}
+
 // SetProviderState updates state gauge for a provider
 // Sets the specified state to 1 and all others to 0
 func SetProviderState(namespace, name, state string) {
-	states := []string{"Cold", "Initializing", "Ready", "Degraded", "Dead"}
+	states := []string{"Cold", "Initializing", "Ready", "Degraded", "Dead", "CircuitOpen", "HalfOpen"}
 	for _, s := range states {
 		val := float64(0)
 		if s == state {
@@ -174,23 +334,55 @@ func SetProviderState(namespace, name, state string) {
 	}
 }
 
+// SetProviderCircuitState updates the circuit breaker gauge for a provider.
+// Unrecognized states (including the steady-state "Closed", which has no
+// dedicated ProviderState value) map to 0.
+func SetProviderCircuitState(namespace, name, state string) {
+	val := float64(0)
+	switch state {
+	case "HalfOpen":
+		val = 1
+	case "CircuitOpen":
+		val = 2
+	}
+	ProviderCircuitState.WithLabelValues(namespace, name).Set(val)
+}
+
 // ClearProviderMetrics removes all metrics for a deleted provider
 func ClearProviderMetrics(namespace, name string) {
-	states := []string{"Cold", "Initializing", "Ready", "Degraded", "Dead"}
+	states := []string{"Cold", "Initializing", "Ready", "Degraded", "Dead", "CircuitOpen", "HalfOpen"}
 	for _, s := range states {
 		ProviderState.DeleteLabelValues(namespace, name, s)
 	}
+	ProviderCircuitState.DeleteLabelValues(namespace, name)
 	ProviderToolsCount.DeleteLabelValues(namespace, name)
 	ProviderHealthCheckFailures.DeleteLabelValues(namespace, name)
 	ProviderRestarts.DeleteLabelValues(namespace, name)
+	ProviderConcurrencyLimit.DeleteLabelValues(namespace, name)
+	ProviderConcurrencyInFlight.DeleteLabelValues(namespace, name)
+}
+
+// SetGroupCircuitState updates the group circuit breaker gauge, setting the
+// current state to 1 and the others to 0.
+func SetGroupCircuitState(namespace, name, state string) {
+	for _, s := range []string{"Closed", "Open", "HalfOpen"} {
+		val := float64(0)
+		if s == state {
+			val = 1
+		}
+		GroupCircuitState.WithLabelValues(namespace, name, s).Set(val)
+	}
 }
 
 // ClearGroupMetrics removes all metrics for a deleted group
 func ClearGroupMetrics(namespace, name string) {
-	states := []string{"Cold", "Initializing", "Ready", "Degraded", "Dead"}
+	states := []string{"Cold", "Initializing", "Ready", "Degraded", "Dead", "CircuitOpen", "HalfOpen"}
 	for _, s := range states {
 		GroupProviderCount.DeleteLabelValues(namespace, name, s)
 	}
+	for _, s := range []string{"Closed", "Open", "HalfOpen"} {
+		GroupCircuitState.DeleteLabelValues(namespace, name, s)
+	}
 }
 
 // ClearDiscoveryMetrics removes all metrics for a deleted discovery source
@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"context"
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -55,6 +56,17 @@ func TestSetProviderState_ClearsOtherStates(t *testing.T) {
 	assert.Equal(t, float64(1), testutil.ToFloat64(ProviderState.WithLabelValues("default", "provider1", "Degraded")))
 }
 
+func TestSetProviderCircuitState(t *testing.T) {
+	SetProviderCircuitState("default", "provider1", "CircuitOpen")
+	assert.Equal(t, float64(2), testutil.ToFloat64(ProviderCircuitState.WithLabelValues("default", "provider1")))
+
+	SetProviderCircuitState("default", "provider1", "HalfOpen")
+	assert.Equal(t, float64(1), testutil.ToFloat64(ProviderCircuitState.WithLabelValues("default", "provider1")))
+
+	SetProviderCircuitState("default", "provider1", "Closed")
+	assert.Equal(t, float64(0), testutil.ToFloat64(ProviderCircuitState.WithLabelValues("default", "provider1")))
+}
+
 func TestProviderToolsCount(t *testing.T) {
 	// Set tool counts
 	ProviderToolsCount.WithLabelValues("default", "provider1").Set(5)
@@ -119,8 +131,128 @@ func TestReconcileTotal_Labels(t *testing.T) {
 	assert.Equal(t, float64(1), testutil.ToFloat64(ReconcileTotal.WithLabelValues("mcpdiscoverysource", "success")))
 }
 
+func TestSetGroupCircuitState(t *testing.T) {
+	SetGroupCircuitState("default", "group1", "Open")
+	assert.Equal(t, float64(1), testutil.ToFloat64(GroupCircuitState.WithLabelValues("default", "group1", "Open")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(GroupCircuitState.WithLabelValues("default", "group1", "Closed")))
+
+	SetGroupCircuitState("default", "group1", "Closed")
+	assert.Equal(t, float64(0), testutil.ToFloat64(GroupCircuitState.WithLabelValues("default", "group1", "Open")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(GroupCircuitState.WithLabelValues("default", "group1", "Closed")))
+}
+
+func TestProviderEjectionsTotal(t *testing.T) {
+	ProviderEjectionsTotal.Reset()
+
+	ProviderEjectionsTotal.WithLabelValues("default", "group1", "consecutive_5xx").Inc()
+	ProviderEjectionsTotal.WithLabelValues("default", "group1", "consecutive_5xx").Inc()
+	ProviderEjectionsTotal.WithLabelValues("default", "group1", "success_rate_outlier").Inc()
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(ProviderEjectionsTotal.WithLabelValues("default", "group1", "consecutive_5xx")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(ProviderEjectionsTotal.WithLabelValues("default", "group1", "success_rate_outlier")))
+}
+
+func TestGroupCircuitTransitionsTotal(t *testing.T) {
+	GroupCircuitTransitionsTotal.Reset()
+
+	GroupCircuitTransitionsTotal.WithLabelValues("default", "group1", "Closed", "Open").Inc()
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(GroupCircuitTransitionsTotal.WithLabelValues("default", "group1", "Closed", "Open")))
+}
+
+func TestOTLPConfigFromEnv_FlagsWinOverEnv(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "from-env:4317")
+	t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "http/protobuf")
+	t.Setenv("OTEL_EXPORTER_OTLP_HEADERS", "x-api-key=envkey")
+	t.Setenv("OTEL_TRACES_SAMPLER_ARG", "0.5")
+
+	cfg := OTLPConfigFromEnv(OTLPConfig{Endpoint: "from-flag:4317", Protocol: "grpc", SamplingRatio: 0.1})
+	assert.Equal(t, "from-flag:4317", cfg.Endpoint)
+	assert.Equal(t, "grpc", cfg.Protocol)
+	assert.Equal(t, 0.1, cfg.SamplingRatio)
+}
+
+func TestOTLPConfigFromEnv_FallsBackToEnv(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "from-env:4317")
+	t.Setenv("OTEL_EXPORTER_OTLP_HEADERS", "x-api-key=envkey,x-tenant=acme")
+	t.Setenv("OTEL_TRACES_SAMPLER_ARG", "0.25")
+
+	cfg := OTLPConfigFromEnv(OTLPConfig{})
+	assert.Equal(t, "from-env:4317", cfg.Endpoint)
+	assert.Equal(t, "envkey", cfg.Headers["x-api-key"])
+	assert.Equal(t, "acme", cfg.Headers["x-tenant"])
+	assert.Equal(t, 0.25, cfg.SamplingRatio)
+}
+
+func TestEnableOTel_NoopWhenPrometheusOnly(t *testing.T) {
+	shutdown, err := EnableOTel(context.Background(), OTLPConfig{Mode: ExporterPrometheus})
+	assert.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+}
+
+func TestEnableOTel_NoopWhenEndpointUnset(t *testing.T) {
+	shutdown, err := EnableOTel(context.Background(), OTLPConfig{Mode: ExporterOTLP})
+	assert.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+}
+
+func TestGroupP2CPicksTotal(t *testing.T) {
+	GroupP2CPicksTotal.Reset()
+
+	GroupP2CPicksTotal.WithLabelValues("default", "group1", "picked").Inc()
+	GroupP2CPicksTotal.WithLabelValues("default", "group1", "picked").Inc()
+	GroupP2CPicksTotal.WithLabelValues("default", "group1", "no_candidates").Inc()
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(GroupP2CPicksTotal.WithLabelValues("default", "group1", "picked")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(GroupP2CPicksTotal.WithLabelValues("default", "group1", "no_candidates")))
+}
+
+func TestProviderConcurrencyLimitAndInFlight(t *testing.T) {
+	ProviderConcurrencyLimit.WithLabelValues("default", "provider1").Set(16)
+	ProviderConcurrencyInFlight.WithLabelValues("default", "provider1").Set(3)
+
+	assert.Equal(t, float64(16), testutil.ToFloat64(ProviderConcurrencyLimit.WithLabelValues("default", "provider1")))
+	assert.Equal(t, float64(3), testutil.ToFloat64(ProviderConcurrencyInFlight.WithLabelValues("default", "provider1")))
+}
+
+func TestProviderRTT(t *testing.T) {
+	ProviderRTT.WithLabelValues("default", "provider1").Observe(0.05)
+
+	count := testutil.CollectAndCount(ProviderRTT)
+	assert.Greater(t, count, 0)
+}
+
+func TestRecordWithExemplar_NoSpanFallsBackToPlainObserve(t *testing.T) {
+	ReconcileDuration.Reset()
+
+	RecordWithExemplar(context.Background(), ReconcileDuration, 0.2, prometheus.Labels{"provider": "provider1"}, "mcpprovider")
+
+	count := testutil.CollectAndCount(ReconcileDuration)
+	assert.Greater(t, count, 0)
+}
+
+func TestRecordWithExemplar_NoExemplarLabelsStillObserves(t *testing.T) {
+	ReconcileDuration.Reset()
+
+	RecordWithExemplar(context.Background(), ReconcileDuration, 0.3, nil, "mcpprovider")
+
+	assert.Greater(t, testutil.CollectAndCount(ReconcileDuration), 0)
+}
+
+func TestEnableNativeHistograms_ReplacesHistogramsAndKeepsWorking(t *testing.T) {
+	EnableNativeHistograms()
+
+	ReconcileDuration.WithLabelValues("mcpprovider").Observe(0.1)
+	HangarClientLatency.WithLabelValues("GetProviderTools").Observe(0.05)
+	DiscoverySyncDuration.WithLabelValues("default", "source1").Observe(1.0)
+
+	assert.Greater(t, testutil.CollectAndCount(ReconcileDuration), 0)
+	assert.Greater(t, testutil.CollectAndCount(HangarClientLatency), 0)
+	assert.Greater(t, testutil.CollectAndCount(DiscoverySyncDuration), 0)
+}
+
 func TestProviderState_AllStates(t *testing.T) {
-	states := []string{"Cold", "Initializing", "Ready", "Degraded", "Dead"}
+	states := []string{"Cold", "Initializing", "Ready", "Degraded", "Dead", "CircuitOpen", "HalfOpen"}
 
 	for _, state := range states {
 		SetProviderState("default", "test-provider", state)
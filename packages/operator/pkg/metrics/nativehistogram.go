@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Defaults for Prometheus native (sparse) histograms, applied when
+// EnableNativeHistograms is called. See the NativeHistogram* fields of
+// prometheus.HistogramOpts for their meaning.
+const (
+	DefaultNativeHistogramBucketFactor     = 1.1
+	DefaultNativeHistogramMaxBucketNumber  = 160
+	DefaultNativeHistogramMinResetDuration = 0
+)
+
+// EnableNativeHistograms re-registers ReconcileDuration, HangarClientLatency,
+// and DiscoverySyncDuration as Prometheus native histograms instead of their
+// default fixed exponential buckets, trading classic buckets for
+// high-resolution, bucket-tuning-free latency distributions. It must be
+// called once, before the manager starts serving metrics, since it replaces
+// the package-level histogram variables these symbols point to; any
+// *HistogramVec captured before this call (there are none at init time) would
+// observe into the old collector.
+func EnableNativeHistograms() {
+	ReconcileDuration = newNativeHistogram(prometheus.HistogramOpts{
+		Namespace: "mcp",
+		Subsystem: "operator",
+		Name:      "reconcile_duration_seconds",
+		Help:      "Duration of reconciliation in seconds",
+	}, []string{"controller"}, ReconcileDuration)
+
+	HangarClientLatency = newNativeHistogram(prometheus.HistogramOpts{
+		Namespace: "mcp",
+		Subsystem: "operator",
+		Name:      "hangar_client_latency_seconds",
+		Help:      "Latency of Hangar client calls",
+	}, []string{"operation"}, HangarClientLatency)
+
+	DiscoverySyncDuration = newNativeHistogram(prometheus.HistogramOpts{
+		Namespace: "mcp",
+		Subsystem: "operator",
+		Name:      "discovery_sync_duration_seconds",
+		Help:      "Duration of discovery sync operations",
+	}, []string{"namespace", "name"}, DiscoverySyncDuration)
+}
+
+// newNativeHistogram unregisters old from the controller-runtime registry,
+// builds a replacement HistogramVec with native-histogram options set on
+// opts, and registers it in old's place.
+func newNativeHistogram(opts prometheus.HistogramOpts, labelNames []string, old *prometheus.HistogramVec) *prometheus.HistogramVec {
+	ctrlmetrics.Registry.Unregister(old)
+
+	opts.NativeHistogramBucketFactor = DefaultNativeHistogramBucketFactor
+	opts.NativeHistogramMaxBucketNumber = DefaultNativeHistogramMaxBucketNumber
+	opts.NativeHistogramMinResetDuration = DefaultNativeHistogramMinResetDuration
+
+	hist := prometheus.NewHistogramVec(opts, labelNames)
+	ctrlmetrics.Registry.MustRegister(hist)
+	return hist
+}
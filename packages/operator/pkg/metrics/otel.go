@@ -0,0 +1,306 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// ExporterMode selects which telemetry backend(s) the operator feeds. The
+// Prometheus collectors in this package are always registered regardless of
+// mode; Mode only controls whether an OTLP pipeline is also started.
+type ExporterMode string
+
+const (
+	ExporterPrometheus ExporterMode = "prometheus"
+	ExporterOTLP       ExporterMode = "otlp"
+	ExporterBoth       ExporterMode = "both"
+)
+
+// OTLPConfig configures the optional OTLP export pipeline. An empty
+// Endpoint leaves OTLP disabled even when Mode requests it.
+type OTLPConfig struct {
+	// Mode selects whether OTLP export runs alongside (or instead of, in
+	// terms of where operators look) the Prometheus scrape endpoint.
+	Mode ExporterMode
+
+	// Endpoint is the OTLP collector address, e.g. "otel-collector:4317"
+	// for gRPC or "http://otel-collector:4318" for HTTP.
+	Endpoint string
+
+	// Protocol is "grpc" or "http/protobuf". Defaults to "grpc".
+	Protocol string
+
+	// Headers are sent with every export request, e.g. for collector auth.
+	Headers map[string]string
+
+	// Insecure disables TLS on the OTLP connection (for sidecar collectors
+	// on a trusted network), for both the gRPC and the HTTP exporters.
+	Insecure bool
+
+	// SamplingRatio is the fraction of traces recorded, in [0,1]. Defaults
+	// to 1 (always sample) when unset.
+	SamplingRatio float64
+}
+
+// OTLPConfigFromEnv overlays standard OTel environment variables onto cfg,
+// matching the env-var precedence the OTel spec defines for SDKs: an
+// explicit field set via flags wins, otherwise the environment is used.
+// See https://opentelemetry.io/docs/specs/otel/configuration/sdk-environment-variables/
+func OTLPConfigFromEnv(cfg OTLPConfig) OTLPConfig {
+	if cfg.Endpoint == "" {
+		if v := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"); v != "" {
+			cfg.Endpoint = v
+		}
+	}
+	if cfg.Protocol == "" {
+		if v := os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL"); v != "" {
+			cfg.Protocol = v
+		}
+	}
+	if len(cfg.Headers) == 0 {
+		if v := os.Getenv("OTEL_EXPORTER_OTLP_HEADERS"); v != "" {
+			cfg.Headers = parseOTLPHeaders(v)
+		}
+	}
+	if cfg.SamplingRatio == 0 {
+		if v := os.Getenv("OTEL_TRACES_SAMPLER_ARG"); v != "" {
+			if ratio, err := strconv.ParseFloat(v, 64); err == nil {
+				cfg.SamplingRatio = ratio
+			}
+		}
+	}
+	return cfg
+}
+
+// parseOTLPHeaders parses the "key1=value1,key2=value2" format
+// OTEL_EXPORTER_OTLP_HEADERS uses.
+func parseOTLPHeaders(raw string) map[string]string {
+	headers := make(map[string]string)
+	for _, pair := range strings.Split(raw, ",") {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return headers
+}
+
+// otelInstruments mirrors the Prometheus collectors above as OTel
+// instruments, built once OTLP export is enabled. A nil *otelInstruments
+// means OTLP export is off and RecordReconcile/ObserveReconcileDuration/
+// Span only touch Prometheus.
+type otelInstruments struct {
+	tracer oteltrace.Tracer
+
+	reconcileTotal    otelmetric.Int64Counter
+	reconcileDuration otelmetric.Float64Histogram
+}
+
+var otelInst *otelInstruments
+
+// EnableOTel starts an OTLP metrics and traces pipeline from cfg and points
+// this package's RecordReconcile/ObserveReconcileDuration/StartReconcileSpan
+// helpers at it, in addition to the Prometheus collectors they already
+// update. It returns a shutdown func to flush and close the exporters on
+// manager stop. Disabled (returns a no-op shutdown, nil error) when
+// cfg.Mode is ExporterPrometheus or cfg.Endpoint is empty.
+func EnableOTel(ctx context.Context, cfg OTLPConfig) (shutdown func(context.Context) error, err error) {
+	noop := func(context.Context) error { return nil }
+	if cfg.Mode == "" || cfg.Mode == ExporterPrometheus || cfg.Endpoint == "" {
+		return noop, nil
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName("mcp-hangar-operator"),
+	))
+	if err != nil {
+		return noop, fmt.Errorf("metrics: failed to build OTel resource: %w", err)
+	}
+
+	metricExporter, err := newOTLPMetricExporter(ctx, cfg)
+	if err != nil {
+		return noop, err
+	}
+	traceExporter, err := newOTLPTraceExporter(ctx, cfg)
+	if err != nil {
+		return noop, err
+	}
+
+	ratio := cfg.SamplingRatio
+	if ratio <= 0 {
+		ratio = 1
+	}
+
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithResource(res),
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter)),
+	)
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithResource(res),
+		sdktrace.WithBatcher(traceExporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	)
+
+	otel.SetMeterProvider(mp)
+	otel.SetTracerProvider(tp)
+
+	meter := mp.Meter(instrumentationName)
+	reconcileTotal, err := meter.Int64Counter(
+		"mcp_operator_reconcile_total",
+		otelmetric.WithDescription("Total number of reconciliations by controller and result"),
+	)
+	if err != nil {
+		return noop, fmt.Errorf("metrics: failed to create reconcile_total counter: %w", err)
+	}
+	reconcileDuration, err := meter.Float64Histogram(
+		"mcp_operator_reconcile_duration_seconds",
+		otelmetric.WithDescription("Duration of reconciliation in seconds"),
+		otelmetric.WithUnit("s"),
+	)
+	if err != nil {
+		return noop, fmt.Errorf("metrics: failed to create reconcile_duration histogram: %w", err)
+	}
+
+	otelInst = &otelInstruments{
+		tracer:            tp.Tracer(instrumentationName),
+		reconcileTotal:    reconcileTotal,
+		reconcileDuration: reconcileDuration,
+	}
+
+	return func(shutdownCtx context.Context) error {
+		otelInst = nil
+		if err := tp.Shutdown(shutdownCtx); err != nil {
+			return err
+		}
+		return mp.Shutdown(shutdownCtx)
+	}, nil
+}
+
+const instrumentationName = "github.com/mapyr/mcp-hangar/operator/pkg/metrics"
+
+func newOTLPMetricExporter(ctx context.Context, cfg OTLPConfig) (sdkmetric.Exporter, error) {
+	if cfg.Protocol == "http/protobuf" || cfg.Protocol == "http" {
+		opts := []otlpmetrichttp.Option{otlpmetrichttp.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlpmetrichttp.WithInsecure())
+		}
+		if len(cfg.Headers) > 0 {
+			opts = append(opts, otlpmetrichttp.WithHeaders(cfg.Headers))
+		}
+		return otlpmetrichttp.New(ctx, opts...)
+	}
+
+	opts := []otlpmetricgrpc.Option{otlpmetricgrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlpmetricgrpc.WithInsecure())
+	}
+	if len(cfg.Headers) > 0 {
+		opts = append(opts, otlpmetricgrpc.WithHeaders(cfg.Headers))
+	}
+	return otlpmetricgrpc.New(ctx, opts...)
+}
+
+func newOTLPTraceExporter(ctx context.Context, cfg OTLPConfig) (sdktrace.SpanExporter, error) {
+	if cfg.Protocol == "http/protobuf" || cfg.Protocol == "http" {
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		if len(cfg.Headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(cfg.Headers))
+		}
+		return otlptracehttp.New(ctx, opts...)
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	if len(cfg.Headers) > 0 {
+		opts = append(opts, otlptracegrpc.WithHeaders(cfg.Headers))
+	}
+	return otlptracegrpc.New(ctx, opts...)
+}
+
+// RecordReconcile records one reconciliation outcome on the Prometheus
+// counter and, when OTLP export is enabled, the mirrored OTel instrument.
+func RecordReconcile(ctx context.Context, controller, result string) {
+	ReconcileTotal.WithLabelValues(controller, result).Inc()
+	if otelInst != nil {
+		otelInst.reconcileTotal.Add(ctx, 1, otelmetric.WithAttributes(
+			attribute.String("controller", controller),
+			attribute.String("result", result),
+		))
+	}
+}
+
+// ObserveReconcileDuration records one reconciliation's duration on the
+// Prometheus histogram (attaching a trace_id/span_id/provider exemplar when
+// a span is active) and, when OTLP export is enabled, the mirrored OTel
+// instrument.
+func ObserveReconcileDuration(ctx context.Context, controller, providerName string, seconds float64) {
+	exemplar := prometheus.Labels{}
+	if providerName != "" {
+		exemplar["provider"] = providerName
+	}
+	RecordWithExemplar(ctx, ReconcileDuration, seconds, exemplar, controller)
+
+	if otelInst != nil {
+		otelInst.reconcileDuration.Record(ctx, seconds, otelmetric.WithAttributes(
+			attribute.String("controller", controller),
+		))
+	}
+}
+
+// RecordWithExemplar observes value on hist, attaching an exemplar carrying
+// trace_id/span_id (merged with any caller-supplied exemplarLabels, e.g.
+// "provider") when ctx holds a sampled span. Falls back to a plain Observe
+// when there's no active span or the histogram has no exemplar support.
+func RecordWithExemplar(ctx context.Context, hist *prometheus.HistogramVec, value float64, exemplarLabels prometheus.Labels, labelValues ...string) {
+	observer := hist.WithLabelValues(labelValues...)
+
+	if sc := oteltrace.SpanContextFromContext(ctx); sc.IsValid() && sc.IsSampled() {
+		labels := exemplarLabels
+		if labels == nil {
+			labels = prometheus.Labels{}
+		}
+		labels["trace_id"] = sc.TraceID().String()
+		labels["span_id"] = sc.SpanID().String()
+
+		if eo, ok := observer.(prometheus.ExemplarObserver); ok {
+			eo.ObserveWithExemplar(value, labels)
+			return
+		}
+	}
+	observer.Observe(value)
+}
+
+// StartReconcileSpan starts a span named "Reconcile.<controller>" when OTLP
+// tracing is enabled, so it's the parent of any Hangar client call spans and
+// provider health check spans made with the returned context. The returned
+// func ends the span; it's a no-op when OTLP export is disabled.
+func StartReconcileSpan(ctx context.Context, controller string) (context.Context, func()) {
+	if otelInst == nil {
+		return ctx, func() {}
+	}
+	ctx, span := otelInst.tracer.Start(ctx, "Reconcile."+controller)
+	return ctx, func() { span.End() }
+}
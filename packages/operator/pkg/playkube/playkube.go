@@ -0,0 +1,185 @@
+// Package playkube converts between plain Kubernetes Pod/Deployment
+// manifests and MCPProvider custom resources, in the spirit of
+// `podman play kube` / `podman generate kube`.
+package playkube
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+	"github.com/mapyr/mcp-hangar/operator/pkg/provider"
+)
+
+// MCPProviderAPIVersion and MCPProviderKind are stamped onto the
+// TypeMeta of MCPProviders this package produces, since the API group has
+// no generated scheme registration to look them up from.
+const (
+	MCPProviderAPIVersion = "mcp-hangar.io/v1alpha1"
+	MCPProviderKind       = "MCPProvider"
+)
+
+// Import reads a Kubernetes-style YAML document containing a Pod or a
+// Deployment with a single container and produces an equivalent
+// MCPProvider, the `play-kube` subcommand's ingestion path.
+func Import(data []byte) (*mcpv1alpha1.MCPProvider, error) {
+	var typeMeta struct {
+		Kind string `json:"kind"`
+	}
+	if err := yaml.Unmarshal(data, &typeMeta); err != nil {
+		return nil, fmt.Errorf("parsing manifest: %w", err)
+	}
+
+	switch typeMeta.Kind {
+	case "Pod":
+		var pod corev1.Pod
+		if err := yaml.Unmarshal(data, &pod); err != nil {
+			return nil, fmt.Errorf("parsing Pod manifest: %w", err)
+		}
+		return FromPod(&pod)
+	case "Deployment":
+		var deploy appsv1.Deployment
+		if err := yaml.Unmarshal(data, &deploy); err != nil {
+			return nil, fmt.Errorf("parsing Deployment manifest: %w", err)
+		}
+		return FromDeployment(&deploy)
+	case "":
+		return nil, fmt.Errorf("manifest has no kind")
+	default:
+		return nil, fmt.Errorf("unsupported kind %q, expected Pod or Deployment", typeMeta.Kind)
+	}
+}
+
+// FromPod builds an MCPProvider from a Pod's first container.
+func FromPod(pod *corev1.Pod) (*mcpv1alpha1.MCPProvider, error) {
+	spec, err := specFromPodSpec(&pod.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mcpv1alpha1.MCPProvider{
+		TypeMeta:   mcpProviderTypeMeta(),
+		ObjectMeta: objectMetaFrom(pod.Name, pod.Namespace, pod.Labels),
+		Spec:       *spec,
+	}, nil
+}
+
+// FromDeployment builds an MCPProvider from a Deployment's pod template,
+// which must declare exactly one container. WorkloadKind is set to
+// Deployment and Replicas carries over from Spec.Replicas.
+func FromDeployment(deploy *appsv1.Deployment) (*mcpv1alpha1.MCPProvider, error) {
+	spec, err := specFromPodSpec(&deploy.Spec.Template.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	spec.WorkloadKind = mcpv1alpha1.WorkloadKindDeployment
+	spec.Replicas = deploy.Spec.Replicas
+
+	return &mcpv1alpha1.MCPProvider{
+		TypeMeta:   mcpProviderTypeMeta(),
+		ObjectMeta: objectMetaFrom(deploy.Name, deploy.Namespace, deploy.Labels),
+		Spec:       *spec,
+	}, nil
+}
+
+// objectMetaFrom carries over only the identifying fields of a source
+// manifest's ObjectMeta; server-assigned fields like ResourceVersion and
+// UID don't make sense on a freshly generated MCPProvider.
+func objectMetaFrom(name, namespace string, labels map[string]string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+		Labels:    labels,
+	}
+}
+
+func mcpProviderTypeMeta() metav1.TypeMeta {
+	return metav1.TypeMeta{APIVersion: MCPProviderAPIVersion, Kind: MCPProviderKind}
+}
+
+// specFromPodSpec maps a PodSpec's single container onto an
+// MCPProviderSpec. It's intentionally lossy: fields BuildWorkloadForProvider
+// doesn't otherwise expose (volume sources, probes in their raw k8s shape,
+// etc.) are dropped rather than best-effort translated, since the generated
+// MCPProvider is a debugging starting point, not a guaranteed round trip.
+func specFromPodSpec(podSpec *corev1.PodSpec) (*mcpv1alpha1.MCPProviderSpec, error) {
+	if len(podSpec.Containers) != 1 {
+		return nil, fmt.Errorf("play-kube only supports a single container, got %d", len(podSpec.Containers))
+	}
+	container := podSpec.Containers[0]
+
+	spec := &mcpv1alpha1.MCPProviderSpec{
+		Mode:               mcpv1alpha1.ProviderModeContainer,
+		Image:              container.Image,
+		Command:            container.Command,
+		Args:               container.Args,
+		WorkingDir:         container.WorkingDir,
+		ImagePullPolicy:    container.ImagePullPolicy,
+		ServiceAccountName: podSpec.ServiceAccountName,
+		NodeSelector:       podSpec.NodeSelector,
+		PriorityClassName:  podSpec.PriorityClassName,
+	}
+
+	for _, ref := range podSpec.ImagePullSecrets {
+		spec.ImagePullSecrets = append(spec.ImagePullSecrets, corev1.LocalObjectReference{Name: ref.Name})
+	}
+
+	for _, env := range container.Env {
+		if env.ValueFrom != nil {
+			continue
+		}
+		spec.Env = append(spec.Env, mcpv1alpha1.EnvVar{Name: env.Name, Value: env.Value})
+	}
+
+	if req := container.Resources.Requests; len(req) > 0 || len(container.Resources.Limits) > 0 {
+		spec.Resources = &mcpv1alpha1.ResourceRequirements{
+			Requests: resourceListFrom(container.Resources.Requests),
+			Limits:   resourceListFrom(container.Resources.Limits),
+		}
+	}
+
+	return spec, nil
+}
+
+func resourceListFrom(list corev1.ResourceList) *mcpv1alpha1.ResourceList {
+	if len(list) == 0 {
+		return nil
+	}
+	result := &mcpv1alpha1.ResourceList{}
+	if cpu, ok := list[corev1.ResourceCPU]; ok {
+		result.CPU = cpu.String()
+	}
+	if mem, ok := list[corev1.ResourceMemory]; ok {
+		result.Memory = mem.String()
+	}
+	return result
+}
+
+// Generate serializes mcpProvider to a plain Pod YAML for offline debugging
+// with `kubectl apply`, the `generate-kube` subcommand's path. The Pod is
+// always built as WorkloadKindPod regardless of the provider's configured
+// WorkloadKind, since a Deployment/StatefulSet round trip isn't the point
+// of this debugging aid.
+func Generate(mcpProvider *mcpv1alpha1.MCPProvider) ([]byte, error) {
+	forPod := *mcpProvider
+	forPod.Spec.WorkloadKind = mcpv1alpha1.WorkloadKindPod
+
+	obj, err := provider.BuildWorkloadForProvider(&forPod)
+	if err != nil {
+		return nil, fmt.Errorf("building pod: %w", err)
+	}
+
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil, fmt.Errorf("expected a Pod, got %T", obj)
+	}
+	pod.TypeMeta.APIVersion = "v1"
+	pod.TypeMeta.Kind = "Pod"
+
+	return yaml.Marshal(pod)
+}
@@ -0,0 +1,117 @@
+package playkube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+func TestFromPod(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-tool", Namespace: "tools"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:    "my-tool",
+					Image:   "example.com/my-tool:latest",
+					Command: []string{"/app/run"},
+					Env:     []corev1.EnvVar{{Name: "FOO", Value: "bar"}},
+				},
+			},
+		},
+	}
+
+	mcpProvider, err := FromPod(pod)
+
+	require.NoError(t, err)
+	assert.Equal(t, MCPProviderKind, mcpProvider.Kind)
+	assert.Equal(t, "my-tool", mcpProvider.Name)
+	assert.Equal(t, "tools", mcpProvider.Namespace)
+	assert.Equal(t, mcpv1alpha1.ProviderModeContainer, mcpProvider.Spec.Mode)
+	assert.Equal(t, "example.com/my-tool:latest", mcpProvider.Spec.Image)
+	assert.Equal(t, []string{"/app/run"}, mcpProvider.Spec.Command)
+	assert.Equal(t, []mcpv1alpha1.EnvVar{{Name: "FOO", Value: "bar"}}, mcpProvider.Spec.Env)
+}
+
+func TestFromPod_RejectsMultipleContainers(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "a"}, {Name: "b"}},
+		},
+	}
+
+	_, err := FromPod(pod)
+
+	require.Error(t, err)
+}
+
+func TestFromDeployment(t *testing.T) {
+	replicas := int32(3)
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-tool", Namespace: "tools"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "my-tool", Image: "example.com/my-tool:latest"},
+					},
+				},
+			},
+		},
+	}
+
+	mcpProvider, err := FromDeployment(deploy)
+
+	require.NoError(t, err)
+	assert.Equal(t, mcpv1alpha1.WorkloadKindDeployment, mcpProvider.Spec.WorkloadKind)
+	require.NotNil(t, mcpProvider.Spec.Replicas)
+	assert.Equal(t, int32(3), *mcpProvider.Spec.Replicas)
+}
+
+func TestImport_Pod(t *testing.T) {
+	data := []byte(`
+kind: Pod
+apiVersion: v1
+metadata:
+  name: my-tool
+spec:
+  containers:
+  - name: my-tool
+    image: example.com/my-tool:latest
+`)
+
+	mcpProvider, err := Import(data)
+
+	require.NoError(t, err)
+	assert.Equal(t, "my-tool", mcpProvider.Name)
+	assert.Equal(t, "example.com/my-tool:latest", mcpProvider.Spec.Image)
+}
+
+func TestImport_RejectsUnsupportedKind(t *testing.T) {
+	_, err := Import([]byte("kind: Service\n"))
+
+	require.Error(t, err)
+}
+
+func TestGenerate(t *testing.T) {
+	mcpProvider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-tool", Namespace: "tools"},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  mcpv1alpha1.ProviderModeContainer,
+			Image: "example.com/my-tool:latest",
+		},
+	}
+
+	out, err := Generate(mcpProvider)
+
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "kind: Pod")
+	assert.Contains(t, string(out), "example.com/my-tool:latest")
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+const (
+	// DefaultScaleUpStabilization applies when AutoscalingConfig or its
+	// ScaleUpStabilization field is unset: scale up immediately.
+	DefaultScaleUpStabilization = 0 * time.Second
+
+	// DefaultScaleDownStabilization applies when AutoscalingConfig or its
+	// ScaleDownStabilization field is unset, matching core HPA's own
+	// default stabilization window for scale-downs.
+	DefaultScaleDownStabilization = 5 * time.Minute
+)
+
+// ResolveMinReplicas returns autoscaling's MinReplicas, defaulting to 0 so
+// IdleTTL's scale-to-zero stays active until a caller opts into a floor.
+func ResolveMinReplicas(autoscaling *mcpv1alpha1.AutoscalingConfig) int32 {
+	if autoscaling == nil || autoscaling.MinReplicas == nil {
+		return 0
+	}
+	return *autoscaling.MinReplicas
+}
+
+// ResolveScaleUpStabilization parses autoscaling's ScaleUpStabilization,
+// defaulting to DefaultScaleUpStabilization when autoscaling is nil, the
+// field is empty, or it fails to parse.
+func ResolveScaleUpStabilization(autoscaling *mcpv1alpha1.AutoscalingConfig) time.Duration {
+	if autoscaling == nil || autoscaling.ScaleUpStabilization == "" {
+		return DefaultScaleUpStabilization
+	}
+	if d, err := time.ParseDuration(autoscaling.ScaleUpStabilization); err == nil {
+		return d
+	}
+	return DefaultScaleUpStabilization
+}
+
+// ResolveScaleDownStabilization parses autoscaling's ScaleDownStabilization,
+// defaulting to DefaultScaleDownStabilization when autoscaling is nil, the
+// field is empty, or it fails to parse.
+func ResolveScaleDownStabilization(autoscaling *mcpv1alpha1.AutoscalingConfig) time.Duration {
+	if autoscaling == nil || autoscaling.ScaleDownStabilization == "" {
+		return DefaultScaleDownStabilization
+	}
+	if d, err := time.ParseDuration(autoscaling.ScaleDownStabilization); err == nil {
+		return d
+	}
+	return DefaultScaleDownStabilization
+}
+
+// ClampReplicas bounds desired between autoscaling's MinReplicas (via
+// ResolveMinReplicas) and MaxReplicas.
+func ClampReplicas(autoscaling *mcpv1alpha1.AutoscalingConfig, desired int32) int32 {
+	if min := ResolveMinReplicas(autoscaling); desired < min {
+		desired = min
+	}
+	if autoscaling.MaxReplicas > 0 && desired > autoscaling.MaxReplicas {
+		desired = autoscaling.MaxReplicas
+	}
+	return desired
+}
+
+// RecommendReplicas applies the ratio core HPA uses for a single metric:
+// scale current so that currentValue divided across the new replica count
+// lands at target's parsed value. An unparseable or non-positive target
+// value is a misconfigured metric, so it recommends no change rather than
+// an arbitrary one.
+func RecommendReplicas(current int32, currentValue float64, target mcpv1alpha1.MetricTarget) int32 {
+	targetValue, err := strconv.ParseFloat(target.Value, 64)
+	if err != nil || targetValue <= 0 {
+		return current
+	}
+	recommended := int32(math.Ceil(float64(current) * (currentValue / targetValue)))
+	if recommended < 0 {
+		return 0
+	}
+	return recommended
+}
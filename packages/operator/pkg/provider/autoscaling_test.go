@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+func TestResolveMinReplicas_DefaultsWhenUnset(t *testing.T) {
+	assert.Equal(t, int32(0), ResolveMinReplicas(nil))
+	assert.Equal(t, int32(0), ResolveMinReplicas(&mcpv1alpha1.AutoscalingConfig{}))
+
+	min := int32(2)
+	assert.Equal(t, int32(2), ResolveMinReplicas(&mcpv1alpha1.AutoscalingConfig{MinReplicas: &min}))
+}
+
+func TestResolveScaleUpStabilization_DefaultsWhenUnset(t *testing.T) {
+	assert.Equal(t, DefaultScaleUpStabilization, ResolveScaleUpStabilization(nil))
+	assert.Equal(t, DefaultScaleUpStabilization, ResolveScaleUpStabilization(&mcpv1alpha1.AutoscalingConfig{}))
+	assert.Equal(t, 30*time.Second, ResolveScaleUpStabilization(&mcpv1alpha1.AutoscalingConfig{ScaleUpStabilization: "30s"}))
+}
+
+func TestResolveScaleDownStabilization_DefaultsWhenUnset(t *testing.T) {
+	assert.Equal(t, DefaultScaleDownStabilization, ResolveScaleDownStabilization(nil))
+	assert.Equal(t, DefaultScaleDownStabilization, ResolveScaleDownStabilization(&mcpv1alpha1.AutoscalingConfig{}))
+	assert.Equal(t, 10*time.Minute, ResolveScaleDownStabilization(&mcpv1alpha1.AutoscalingConfig{ScaleDownStabilization: "10m"}))
+}
+
+func TestClampReplicas_BoundsToMinAndMax(t *testing.T) {
+	min := int32(1)
+	autoscaling := &mcpv1alpha1.AutoscalingConfig{MinReplicas: &min, MaxReplicas: 5}
+
+	assert.Equal(t, int32(1), ClampReplicas(autoscaling, 0))
+	assert.Equal(t, int32(5), ClampReplicas(autoscaling, 9))
+	assert.Equal(t, int32(3), ClampReplicas(autoscaling, 3))
+}
+
+func TestRecommendReplicas_ScalesByRatio(t *testing.T) {
+	target := mcpv1alpha1.MetricTarget{Type: mcpv1alpha1.MetricTargetAverageValue, Value: "10"}
+
+	assert.Equal(t, int32(4), RecommendReplicas(2, 20, target))
+	assert.Equal(t, int32(1), RecommendReplicas(2, 5, target))
+}
+
+func TestRecommendReplicas_NoChangeOnBadTarget(t *testing.T) {
+	assert.Equal(t, int32(3), RecommendReplicas(3, 20, mcpv1alpha1.MetricTarget{Value: "not-a-number"}))
+	assert.Equal(t, int32(3), RecommendReplicas(3, 20, mcpv1alpha1.MetricTarget{Value: "0"}))
+}
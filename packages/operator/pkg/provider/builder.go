@@ -2,12 +2,21 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"math"
 	"strconv"
+	"strings"
+	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
 )
@@ -22,81 +31,849 @@ const (
 	LabelProvider    = "mcp-hangar.io/provider"
 	LabelProviderUID = "mcp-hangar.io/provider-uid"
 
+	// LabelPodOrdinal records a replicated WorkloadKindPod Pod's ordinal
+	// (its position in BuildPodsForProvider's output), the same way
+	// StatefulSet encodes ordinal identity in the Pod name rather than a
+	// label - we also label it so the reconciler can list and sort a
+	// provider's replicas without parsing names.
+	LabelPodOrdinal = "mcp-hangar.io/pod-ordinal"
+
+	// LabelTemplateHash records the hash of the Pod template a replicated
+	// WorkloadKindPod Pod was built from, mirroring the
+	// pod-template-hash label Deployment/ReplicaSet use to tell which
+	// Pods are on the current template during a rolling update.
+	LabelTemplateHash = "mcp-hangar.io/template-hash"
+
 	// Annotations
 	AnnotationGeneration = "mcp-hangar.io/generation"
 	AnnotationConfigHash = "mcp-hangar.io/config-hash"
 
+	// AppArmorAnnotationPrefix is the well-known Kubernetes annotation the
+	// kubelet reads an AppArmor profile from, since it predates (and, for
+	// older kubelets, still takes precedence over) a SecurityContext field.
+	AppArmorAnnotationPrefix = "container.apparmor.security.beta.kubernetes.io/"
+
 	// Container names
-	ContainerProvider = "provider"
+	ContainerProvider     = "provider"
+	ContainerHelper       = "helper"
+	ContainerInitPrefetch = "init-prefetch"
 
 	// Default values
-	DefaultManagerName = "mcp-hangar-operator"
+	DefaultManagerName        = "mcp-hangar-operator"
+	DefaultInitPrefetchImage  = "mcp-hangar/bundle-prefetcher:latest"
+	DefaultInitPrefetchTarget = "/mcp/bundles"
+
+	// MCPProbeBinaryPath is the path of the probe binary the operator
+	// expects the provider image to bundle for MCPInitialize probes.
+	MCPProbeBinaryPath = "/mcp-probe"
+
+	// DefaultMCPInitializeTransport is used when Probe.MCPInitialize.Transport is unset
+	DefaultMCPInitializeTransport = "stdio"
+
+	// DefaultMCPInitializePath is used when Probe.MCPInitialize.Path is unset
+	DefaultMCPInitializePath = "/rpc"
+
+	// DefaultTerminationGracePeriodSeconds is used when ShutdownGracePeriod is unset.
+	DefaultTerminationGracePeriodSeconds = int64(30)
+
+	// MaxTerminationGracePeriodSeconds clamps an operator-supplied
+	// ShutdownGracePeriod so a typo like "30h" can't leave a pod stuck
+	// Terminating for an unreasonable amount of time.
+	MaxTerminationGracePeriodSeconds = int64(2 * 60 * 60)
+
+	// ImagePullCredentialsSecretSuffix names the generated
+	// kubernetes.io/dockerconfigjson Secret materialized from
+	// Spec.ImagePullCredentials.ConfigMapRef.
+	ImagePullCredentialsSecretSuffix = "-pull-credentials"
+
+	// DefaultImagePullCredentialsConfigMapKey is used when
+	// ImagePullCredentials.ConfigMapRef.Key is unset.
+	DefaultImagePullCredentialsConfigMapKey = "auths"
 )
 
-// BuildPodForProvider creates a Pod spec from MCPProvider
-func BuildPodForProvider(provider *mcpv1alpha1.MCPProvider) (*corev1.Pod, error) {
+// BuildOption customizes BuildWorkloadForProvider for cluster capabilities
+// that can't be inferred from the MCPProvider spec alone.
+type BuildOption func(*buildOptions)
+
+type buildOptions struct {
+	genericEphemeralVolumesDisabled bool
+}
+
+// WithGenericEphemeralVolumesDisabled rejects providers that request an
+// Ephemeral volume, for clusters where the GenericEphemeralVolume feature
+// gate is off.
+func WithGenericEphemeralVolumesDisabled() BuildOption {
+	return func(o *buildOptions) { o.genericEphemeralVolumesDisabled = true }
+}
+
+// BuildWorkloadForProvider creates the Kubernetes workload for provider
+// according to its WorkloadKind: a bare Pod (the default, restarted by the
+// reconciler rather than the kubelet), a Deployment for stateless
+// long-running providers, or a StatefulSet for providers that need stable
+// identity and per-replica storage.
+func BuildWorkloadForProvider(provider *mcpv1alpha1.MCPProvider, opts ...BuildOption) (client.Object, error) {
 	if provider.Spec.Image == "" {
 		return nil, fmt.Errorf("container mode requires image")
 	}
 
-	podName := provider.GetPodName()
+	options := &buildOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
 
-	// Build main container
-	container := buildContainer(provider)
+	restartPolicy := corev1.RestartPolicyNever
+	if provider.Spec.WorkloadKind == mcpv1alpha1.WorkloadKindDeployment || provider.Spec.WorkloadKind == mcpv1alpha1.WorkloadKindStatefulSet {
+		restartPolicy = corev1.RestartPolicyAlways
+	}
 
-	// Build volumes
-	volumeMounts, volumes := buildVolumes(provider)
-	container.VolumeMounts = volumeMounts
+	podSpec, err := buildPodSpec(provider, options, restartPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := enforcePodSecurityStandard(provider.Spec.SecurityProfile, podSpec); err != nil {
+		return nil, err
+	}
 
-	// Build Pod
-	pod := &corev1.Pod{
+	annotations := map[string]string{
+		AnnotationGeneration: strconv.FormatInt(provider.Generation, 10),
+	}
+	for name, value := range buildAppArmorAnnotations(provider) {
+		annotations[name] = value
+	}
+
+	labels := buildLabels(provider)
+	template := corev1.PodTemplateSpec{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      podName,
-			Namespace: provider.Namespace,
-			Labels:    buildLabels(provider),
-			Annotations: map[string]string{
-				AnnotationGeneration: strconv.FormatInt(provider.Generation, 10),
-			},
-		},
-		Spec: corev1.PodSpec{
-			Containers:                    []corev1.Container{container},
-			Volumes:                       volumes,
-			RestartPolicy:                 corev1.RestartPolicyNever, // Operator manages restarts
-			ServiceAccountName:            provider.Spec.ServiceAccountName,
-			NodeSelector:                  provider.Spec.NodeSelector,
-			ImagePullSecrets:              provider.Spec.ImagePullSecrets,
-			PriorityClassName:             provider.Spec.PriorityClassName,
-			TerminationGracePeriodSeconds: getTerminationGracePeriod(provider),
+			Labels:      labels,
+			Annotations: annotations,
 		},
+		Spec: *podSpec,
+	}
+
+	if err := applyPodTemplateOverride(&template, provider); err != nil {
+		return nil, err
+	}
+
+	switch provider.Spec.WorkloadKind {
+	case mcpv1alpha1.WorkloadKindDeployment:
+		return buildDeployment(provider, template, labels), nil
+	case mcpv1alpha1.WorkloadKindStatefulSet:
+		return buildStatefulSet(provider, template, labels), nil
+	case "", mcpv1alpha1.WorkloadKindPod:
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        provider.GetPodName(),
+				Namespace:   provider.Namespace,
+				Labels:      template.ObjectMeta.Labels,
+				Annotations: template.ObjectMeta.Annotations,
+			},
+			Spec: template.Spec,
+		}, nil
+	default:
+		return nil, fmt.Errorf("workloadKind %q is not recognized", provider.Spec.WorkloadKind)
+	}
+}
+
+// PodOrdinalName returns the deterministic name of the ordinal-th replica of
+// a WorkloadKindPod provider with more than one replica, following the same
+// "<base>-<ordinal>" convention StatefulSet uses for its Pods. Ordinal 0
+// returns provider.GetPodName() unchanged, so a single-replica provider's
+// Pod name never changes when Replicas grows from 1.
+func PodOrdinalName(provider *mcpv1alpha1.MCPProvider, ordinal int32) string {
+	if ordinal == 0 {
+		return provider.GetPodName()
+	}
+	return fmt.Sprintf("%s-%d", provider.GetPodName(), ordinal)
+}
+
+// BuildPodsForProvider builds one Pod per provider.GetReplicas() for a
+// WorkloadKindPod provider with more than one replica, named by
+// PodOrdinalName and labeled with LabelPodOrdinal and LabelTemplateHash so
+// the reconciler can tell which Pods belong to the current template during
+// a rolling update.
+func BuildPodsForProvider(provider *mcpv1alpha1.MCPProvider, opts ...BuildOption) ([]*corev1.Pod, error) {
+	desired, err := BuildWorkloadForProvider(provider, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	template, ok := desired.(*corev1.Pod)
+	if !ok {
+		return nil, fmt.Errorf("BuildPodsForProvider requires workloadKind Pod, got %T", desired)
+	}
+
+	hash := PodTemplateHash(template)
+
+	replicas := provider.GetReplicas()
+	pods := make([]*corev1.Pod, 0, replicas)
+	for ordinal := int32(0); ordinal < replicas; ordinal++ {
+		pod := template.DeepCopy()
+		pod.Name = PodOrdinalName(provider, ordinal)
+		if pod.Labels == nil {
+			pod.Labels = map[string]string{}
+		}
+		pod.Labels[LabelPodOrdinal] = strconv.Itoa(int(ordinal))
+		pod.Labels[LabelTemplateHash] = hash
+		applyPersistentState(provider, pod, ordinal)
+		pods = append(pods, pod)
+	}
+	return pods, nil
+}
+
+// PodTemplateHash returns a short, stable hash of pod.Spec, used to detect
+// when a provider's Pod template has changed and a replicated
+// WorkloadKindPod provider needs a rolling update.
+func PodTemplateHash(pod *corev1.Pod) string {
+	specJSON, err := json.Marshal(pod.Spec)
+	if err != nil {
+		// Spec always marshals; this would only trip on a programming
+		// error, and a hash collision here just forces an extra rollout.
+		return ""
+	}
+	h := fnv.New32a()
+	h.Write(specJSON)
+	return strconv.FormatUint(uint64(h.Sum32()), 36)
+}
+
+// buildPodSpec assembles the PodSpec shared by the Pod, Deployment, and
+// StatefulSet workload kinds.
+func buildPodSpec(provider *mcpv1alpha1.MCPProvider, options *buildOptions, restartPolicy corev1.RestartPolicy) (*corev1.PodSpec, error) {
+	// Build volumes
+	volumeMounts, volumes, err := buildVolumes(provider, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build main, sidecar, and helper containers
+	containers, err := buildContainers(provider, volumeMounts)
+	if err != nil {
+		return nil, err
+	}
+
+	podSpec := &corev1.PodSpec{
+		Containers:                    containers,
+		InitContainers:                buildInitContainers(provider),
+		Volumes:                       volumes,
+		RestartPolicy:                 restartPolicy,
+		ServiceAccountName:            provider.Spec.ServiceAccountName,
+		NodeSelector:                  provider.Spec.NodeSelector,
+		ImagePullSecrets:              buildImagePullSecrets(provider),
+		PriorityClassName:             provider.Spec.PriorityClassName,
+		TerminationGracePeriodSeconds: getTerminationGracePeriod(provider),
 	}
 
 	// Tolerations
 	if len(provider.Spec.Tolerations) > 0 {
-		pod.Spec.Tolerations = buildTolerations(provider.Spec.Tolerations)
+		podSpec.Tolerations = buildTolerations(provider.Spec.Tolerations)
 	}
 
 	// Affinity
 	if provider.Spec.Affinity != nil {
-		pod.Spec.Affinity = provider.Spec.Affinity
+		podSpec.Affinity = provider.Spec.Affinity
 	}
 
 	// Pod security context
 	if provider.Spec.SecurityContext != nil {
-		pod.Spec.SecurityContext = buildPodSecurityContext(provider.Spec.SecurityContext)
+		podSpec.SecurityContext = buildPodSecurityContext(provider.Spec.SecurityContext)
 	} else {
 		// Secure defaults
-		pod.Spec.SecurityContext = defaultPodSecurityContext()
+		podSpec.SecurityContext = defaultPodSecurityContext()
+	}
+
+	return podSpec, nil
+}
+
+// applyPodTemplateOverride strategic-merge-patches Spec.PodTemplate onto
+// template, the Pod template BuildWorkloadForProvider has just assembled
+// from the rest of the spec. template is the higher-precedence side of the
+// merge - its env vars, labels, security defaults, and the provider
+// container's own image always win over a matching field in PodTemplate -
+// so PodTemplate can only add fields BuildWorkloadForProvider doesn't
+// otherwise set, not override ones it does.
+func applyPodTemplateOverride(template *corev1.PodTemplateSpec, provider *mcpv1alpha1.MCPProvider) error {
+	if provider.Spec.PodTemplate == nil {
+		return nil
+	}
+
+	if provider.Spec.Image != "" {
+		for _, c := range provider.Spec.PodTemplate.Spec.Containers {
+			if c.Name == ContainerProvider && c.Image != "" && c.Image != provider.Spec.Image {
+				return fmt.Errorf("podTemplate may not override the %q container's image when spec.image is set", ContainerProvider)
+			}
+		}
+	}
+
+	baseJSON, err := json.Marshal(&corev1.Pod{
+		ObjectMeta: provider.Spec.PodTemplate.ObjectMeta,
+		Spec:       provider.Spec.PodTemplate.Spec,
+	})
+	if err != nil {
+		return fmt.Errorf("marshaling podTemplate: %w", err)
+	}
+
+	patchJSON, err := json.Marshal(&corev1.Pod{
+		ObjectMeta: template.ObjectMeta,
+		Spec:       template.Spec,
+	})
+	if err != nil {
+		return fmt.Errorf("marshaling built pod template: %w", err)
+	}
+
+	mergedJSON, err := strategicpatch.StrategicMergePatch(baseJSON, patchJSON, &corev1.Pod{})
+	if err != nil {
+		return fmt.Errorf("merging podTemplate: %w", err)
+	}
+
+	var merged corev1.Pod
+	if err := json.Unmarshal(mergedJSON, &merged); err != nil {
+		return fmt.Errorf("unmarshaling merged pod template: %w", err)
+	}
+
+	template.ObjectMeta = merged.ObjectMeta
+	template.Spec = merged.Spec
+	return nil
+}
+
+// buildDeployment wraps template in a Deployment sized and updated
+// according to the provider spec.
+func buildDeployment(provider *mcpv1alpha1.MCPProvider, template corev1.PodTemplateSpec, labels map[string]string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      provider.GetPodName(),
+			Namespace: provider.Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: provider.Spec.Replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: buildSelectorLabels(provider)},
+			Template: template,
+			Strategy: buildDeploymentStrategy(provider.Spec.UpdateStrategy),
+		},
+	}
+}
+
+// buildStatefulSet wraps template in a StatefulSet sized and updated
+// according to the provider spec, with its VolumeClaimTemplates and
+// headless ServiceName.
+func buildStatefulSet(provider *mcpv1alpha1.MCPProvider, template corev1.PodTemplateSpec, labels map[string]string) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      provider.GetPodName(),
+			Namespace: provider.Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:             provider.Spec.Replicas,
+			Selector:             &metav1.LabelSelector{MatchLabels: buildSelectorLabels(provider)},
+			Template:             template,
+			ServiceName:          provider.Spec.ServiceName,
+			UpdateStrategy:       buildStatefulSetUpdateStrategy(provider.Spec.UpdateStrategy),
+			VolumeClaimTemplates: buildVolumeClaimTemplates(provider.Spec.VolumeClaimTemplates),
+		},
+	}
+}
+
+// buildSelectorLabels returns the stable label subset used to select the
+// Pods owned by a Deployment or StatefulSet. It must never change across
+// reconciles, so it excludes the provider UID label.
+func buildSelectorLabels(provider *mcpv1alpha1.MCPProvider) map[string]string {
+	return map[string]string{
+		LabelManagedBy: DefaultManagerName,
+		LabelInstance:  provider.Name,
+		LabelProvider:  provider.Name,
+	}
+}
+
+// SelectorLabels returns the same stable label subset buildSelectorLabels
+// uses for a Deployment or StatefulSet's pod selector. Exported so the
+// controller can match it exactly when it builds the headless Service for a
+// StatefulSet workload.
+func SelectorLabels(provider *mcpv1alpha1.MCPProvider) map[string]string {
+	return buildSelectorLabels(provider)
+}
+
+// buildDeploymentStrategy translates our UpdateStrategy into a
+// DeploymentStrategy, defaulting to RollingUpdate.
+func buildDeploymentStrategy(strategy *mcpv1alpha1.UpdateStrategy) appsv1.DeploymentStrategy {
+	if strategy != nil && strategy.Type == mcpv1alpha1.UpdateStrategyRecreate {
+		return appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType}
+	}
+	return appsv1.DeploymentStrategy{Type: appsv1.RollingUpdateDeploymentStrategyType}
+}
+
+// buildStatefulSetUpdateStrategy translates our UpdateStrategy into a
+// StatefulSetUpdateStrategy, defaulting to RollingUpdate.
+func buildStatefulSetUpdateStrategy(strategy *mcpv1alpha1.UpdateStrategy) appsv1.StatefulSetUpdateStrategy {
+	if strategy != nil && strategy.Type == mcpv1alpha1.UpdateStrategyRecreate {
+		return appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType}
+	}
+	return appsv1.StatefulSetUpdateStrategy{Type: appsv1.RollingUpdateStatefulSetStrategyType}
+}
+
+// buildVolumeClaimTemplates converts our PVC templates into k8s
+// PersistentVolumeClaims suitable for StatefulSetSpec.VolumeClaimTemplates.
+func buildVolumeClaimTemplates(templates []mcpv1alpha1.PersistentVolumeClaimTemplate) []corev1.PersistentVolumeClaim {
+	if len(templates) == 0 {
+		return nil
+	}
+
+	result := make([]corev1.PersistentVolumeClaim, len(templates))
+	for i, tmpl := range templates {
+		result[i] = corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: tmpl.Name},
+			Spec:       buildPVCClaimSpec(tmpl),
+		}
+	}
+	return result
+}
+
+// buildPVCClaimSpec converts a single PVC template into the
+// PersistentVolumeClaimSpec shared by StatefulSet's VolumeClaimTemplates and
+// PersistentState's per-ordinal PVCs.
+func buildPVCClaimSpec(tmpl mcpv1alpha1.PersistentVolumeClaimTemplate) corev1.PersistentVolumeClaimSpec {
+	accessModes := make([]corev1.PersistentVolumeAccessMode, len(tmpl.AccessModes))
+	for i, mode := range tmpl.AccessModes {
+		accessModes[i] = corev1.PersistentVolumeAccessMode(mode)
+	}
+
+	return corev1.PersistentVolumeClaimSpec{
+		StorageClassName: tmpl.StorageClassName,
+		AccessModes:      accessModes,
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceStorage: resource.MustParse(tmpl.Storage),
+			},
+		},
+	}
+}
+
+// PVCOrdinalName returns the name of the PVC PersistentState provisions for
+// claim at the given ordinal, following the "<claim>-<pod name>" convention
+// StatefulSet uses for its own per-replica PVCs.
+func PVCOrdinalName(provider *mcpv1alpha1.MCPProvider, claimName string, ordinal int32) string {
+	return fmt.Sprintf("%s-%s", claimName, PodOrdinalName(provider, ordinal))
+}
+
+// BuildPersistentStatePVCs builds the per-ordinal PVCs a PersistentState
+// provider needs for its current Replicas. The reconciler creates any that
+// don't exist yet and never deletes one, so scaling down (including to zero
+// on IdleTTL) and back up reattaches the same ordinal's PVC rather than
+// losing its data.
+func BuildPersistentStatePVCs(provider *mcpv1alpha1.MCPProvider) []*corev1.PersistentVolumeClaim {
+	persistentState := provider.Spec.PersistentState
+	if persistentState == nil {
+		return nil
+	}
+
+	replicas := provider.GetReplicas()
+	pvcs := make([]*corev1.PersistentVolumeClaim, 0, int(replicas)*len(persistentState.VolumeClaimTemplates))
+	for ordinal := int32(0); ordinal < replicas; ordinal++ {
+		for _, tmpl := range persistentState.VolumeClaimTemplates {
+			pvcs = append(pvcs, &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      PVCOrdinalName(provider, tmpl.Name, ordinal),
+					Namespace: provider.Namespace,
+					Labels:    buildSelectorLabels(provider),
+				},
+				Spec: buildPVCClaimSpec(tmpl),
+			})
+		}
+	}
+	return pvcs
+}
+
+// applyPersistentState wires a PersistentState provider's per-ordinal PVCs
+// and, if StableNetworkID is set, stable hostname into pod - the Pod
+// equivalent of what StatefulSet's controller does natively for a
+// VolumeClaimTemplates/ServiceName StatefulSet.
+func applyPersistentState(provider *mcpv1alpha1.MCPProvider, pod *corev1.Pod, ordinal int32) {
+	persistentState := provider.Spec.PersistentState
+	if persistentState == nil {
+		return
+	}
+
+	for _, tmpl := range persistentState.VolumeClaimTemplates {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: tmpl.Name,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: PVCOrdinalName(provider, tmpl.Name, ordinal),
+				},
+			},
+		})
+	}
+
+	if persistentState.StableNetworkID {
+		pod.Spec.Hostname = PodOrdinalName(provider, ordinal)
+		pod.Spec.Subdomain = provider.Spec.ServiceName
+	}
+}
+
+// enforcePodSecurityStandard gates the pod template against the named Pod
+// Security Standards profile. Empty and "privileged" apply no gating.
+func enforcePodSecurityStandard(profile mcpv1alpha1.SecurityProfile, podSpec *corev1.PodSpec) error {
+	switch profile {
+	case "", mcpv1alpha1.SecurityProfilePrivileged:
+		return nil
+	case mcpv1alpha1.SecurityProfileBaseline, mcpv1alpha1.SecurityProfileRestricted:
+		if violations := podSecurityStandardViolations(profile, podSpec); len(violations) > 0 {
+			return &PodSecurityStandardError{Profile: profile, Violations: violations}
+		}
+		return nil
+	default:
+		return fmt.Errorf("securityProfile %q is not a recognized Pod Security Standards profile", profile)
+	}
+}
+
+// PodSecurityStandardError collects every Pod Security Standards violation
+// found for a build so the spec can be fixed in one pass instead of
+// iterating error-by-error.
+type PodSecurityStandardError struct {
+	Profile    mcpv1alpha1.SecurityProfile
+	Violations []string
+}
+
+func (e *PodSecurityStandardError) Error() string {
+	return fmt.Sprintf("pod template violates the %s Pod Security Standard profile:\n- %s", e.Profile, strings.Join(e.Violations, "\n- "))
+}
+
+// baselineAllowedCapabilities mirrors the Kubernetes baseline Pod Security
+// Standard's default capability allow-list.
+var baselineAllowedCapabilities = map[string]bool{
+	"AUDIT_WRITE": true, "CHOWN": true, "DAC_OVERRIDE": true, "FOWNER": true,
+	"FSETID": true, "KILL": true, "MKNOD": true, "NET_BIND_SERVICE": true,
+	"SETFCAP": true, "SETGID": true, "SETPCAP": true, "SETUID": true, "SYS_CHROOT": true,
+}
+
+// restrictedAllowedCapabilities is the narrower allow-list for the
+// restricted profile.
+var restrictedAllowedCapabilities = map[string]bool{"NET_BIND_SERVICE": true}
+
+// podSecurityStandardViolations returns every violation of the given
+// profile found in podSpec, rather than stopping at the first.
+func podSecurityStandardViolations(profile mcpv1alpha1.SecurityProfile, podSpec *corev1.PodSpec) []string {
+	var violations []string
+	violations = append(violations, hostNamespaceViolations(podSpec)...)
+	violations = append(violations, hostPathVolumeViolations(podSpec)...)
+
+	allowedCaps := baselineAllowedCapabilities
+	if profile == mcpv1alpha1.SecurityProfileRestricted {
+		allowedCaps = restrictedAllowedCapabilities
+	}
+
+	for _, c := range podSpec.Containers {
+		violations = append(violations, containerBaselineViolations(c, allowedCaps)...)
+		if profile == mcpv1alpha1.SecurityProfileRestricted {
+			violations = append(violations, containerRestrictedViolations(podSpec, c)...)
+		}
+	}
+
+	return violations
+}
+
+// hostNamespaceViolations forbids sharing the host's network, PID, or IPC
+// namespaces, which both PSS profiles reject.
+func hostNamespaceViolations(podSpec *corev1.PodSpec) []string {
+	var violations []string
+	if podSpec.HostNetwork {
+		violations = append(violations, "hostNetwork must not be true")
+	}
+	if podSpec.HostPID {
+		violations = append(violations, "hostPID must not be true")
+	}
+	if podSpec.HostIPC {
+		violations = append(violations, "hostIPC must not be true")
+	}
+	return violations
+}
+
+// hostPathVolumeViolations forbids hostPath volumes, which both PSS
+// profiles reject.
+func hostPathVolumeViolations(podSpec *corev1.PodSpec) []string {
+	var violations []string
+	for _, v := range podSpec.Volumes {
+		if v.HostPath != nil {
+			violations = append(violations, fmt.Sprintf("volume %q: hostPath volumes are not allowed", v.Name))
+		}
+	}
+	return violations
+}
+
+// containerBaselineViolations checks the rules shared by both the baseline
+// and restricted profiles: no privileged containers and no capabilities
+// added outside the given allow-list.
+func containerBaselineViolations(c corev1.Container, allowedCaps map[string]bool) []string {
+	var violations []string
+	sc := c.SecurityContext
+	if sc == nil {
+		return violations
+	}
+
+	if sc.Privileged != nil && *sc.Privileged {
+		violations = append(violations, fmt.Sprintf("container %q: privileged must not be true", c.Name))
+	}
+	if sc.Capabilities != nil {
+		for _, add := range sc.Capabilities.Add {
+			if !allowedCaps[string(add)] {
+				violations = append(violations, fmt.Sprintf("container %q: capabilities.add %q is not allowed", c.Name, add))
+			}
+		}
+	}
+	return violations
+}
+
+// containerRestrictedViolations checks the additional rules the restricted
+// profile enforces on top of the baseline ones.
+func containerRestrictedViolations(podSpec *corev1.PodSpec, c corev1.Container) []string {
+	var violations []string
+	sc := c.SecurityContext
+
+	if runAsNonRoot := effectiveRunAsNonRoot(podSpec, sc); runAsNonRoot == nil || !*runAsNonRoot {
+		violations = append(violations, fmt.Sprintf("container %q: runAsNonRoot must be true", c.Name))
+	}
+	if sc == nil || sc.AllowPrivilegeEscalation == nil || *sc.AllowPrivilegeEscalation {
+		violations = append(violations, fmt.Sprintf("container %q: allowPrivilegeEscalation must be false", c.Name))
+	}
+	if sc == nil || sc.ReadOnlyRootFilesystem == nil || !*sc.ReadOnlyRootFilesystem {
+		violations = append(violations, fmt.Sprintf("container %q: readOnlyRootFilesystem must be true", c.Name))
+	}
+	if sc == nil || sc.Capabilities == nil || !containsCapability(sc.Capabilities.Drop, "ALL") {
+		violations = append(violations, fmt.Sprintf("container %q: capabilities.drop must include ALL", c.Name))
+	}
+	if seccomp := effectiveSeccompProfile(podSpec, sc); seccomp == nil ||
+		(seccomp.Type != corev1.SeccompProfileTypeRuntimeDefault && seccomp.Type != corev1.SeccompProfileTypeLocalhost) {
+		violations = append(violations, fmt.Sprintf("container %q: seccompProfile.type must be RuntimeDefault or Localhost", c.Name))
+	}
+
+	return violations
+}
+
+// effectiveRunAsNonRoot resolves runAsNonRoot the way the kubelet does: the
+// container's own setting wins, falling back to the pod-level setting.
+func effectiveRunAsNonRoot(podSpec *corev1.PodSpec, sc *corev1.SecurityContext) *bool {
+	if sc != nil && sc.RunAsNonRoot != nil {
+		return sc.RunAsNonRoot
+	}
+	if podSpec.SecurityContext != nil {
+		return podSpec.SecurityContext.RunAsNonRoot
+	}
+	return nil
+}
+
+// effectiveSeccompProfile resolves the seccomp profile the way the kubelet
+// does: the container's own setting wins, falling back to the pod-level
+// setting.
+func effectiveSeccompProfile(podSpec *corev1.PodSpec, sc *corev1.SecurityContext) *corev1.SeccompProfile {
+	if sc != nil && sc.SeccompProfile != nil {
+		return sc.SeccompProfile
+	}
+	if podSpec.SecurityContext != nil {
+		return podSpec.SecurityContext.SeccompProfile
+	}
+	return nil
+}
+
+// containsCapability reports whether caps contains name.
+func containsCapability(caps []corev1.Capability, name corev1.Capability) bool {
+	for _, c := range caps {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// buildContainers assembles every container that runs in provider's Pod:
+// the main provider container, any Sidecars with no RestartPolicy (each
+// mounting only the Volumes it lists), and the well-known Helper container
+// last, which mirrors the main container's Volume mounts. Sidecars with
+// RestartPolicy "Always" are native sidecars and are built by
+// buildInitContainers instead, since the kubelet only honors that field on
+// init containers.
+func buildContainers(provider *mcpv1alpha1.MCPProvider, sharedVolumeMounts []corev1.VolumeMount) ([]corev1.Container, error) {
+	main, err := buildContainer(provider)
+	if err != nil {
+		return nil, err
+	}
+	main.VolumeMounts = sharedVolumeMounts
+
+	containers := []corev1.Container{main}
+
+	for _, sidecar := range provider.Spec.Sidecars {
+		if sidecar.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+			continue
+		}
+		containers = append(containers, buildSidecarContainer(provider, sidecar))
+	}
+
+	if provider.Spec.Helper != nil {
+		helper := buildHelperContainer(provider, provider.Spec.Helper)
+		helper.VolumeMounts = sharedVolumeMounts
+		containers = append(containers, helper)
+	}
+
+	return containers, nil
+}
+
+// buildSidecarContainer creates a Sidecar container, mounting only the
+// Volumes it lists in its own VolumeMounts. Native sidecars (RestartPolicy
+// "Always") additionally carry that RestartPolicy so the kubelet starts
+// them before the main container and keeps them running.
+func buildSidecarContainer(provider *mcpv1alpha1.MCPProvider, sidecar mcpv1alpha1.SidecarSpec) corev1.Container {
+	container := corev1.Container{
+		Name:         sidecar.Name,
+		Image:        sidecar.Image,
+		Command:      sidecar.Command,
+		Args:         sidecar.Args,
+		Env:          buildContainerEnvVars(provider, sidecar.Env),
+		VolumeMounts: buildVolumeMounts(sidecar.VolumeMounts),
+	}
+
+	if sidecar.Resources != nil {
+		container.Resources = buildResourceRequirements(sidecar.Resources)
+	}
+
+	if sidecar.SecurityContext != nil {
+		container.SecurityContext = buildContainerSecurityContext(sidecar.SecurityContext)
+	} else {
+		container.SecurityContext = defaultContainerSecurityContext()
+	}
+
+	if sidecar.RestartPolicy != "" {
+		restartPolicy := sidecar.RestartPolicy
+		container.RestartPolicy = &restartPolicy
 	}
 
-	return pod, nil
+	return container
+}
+
+// buildHelperContainer creates the well-known Helper container. Its
+// VolumeMounts are set by the caller to match the main container's, since
+// the helper always shares them.
+func buildHelperContainer(provider *mcpv1alpha1.MCPProvider, helper *mcpv1alpha1.HelperSpec) corev1.Container {
+	container := corev1.Container{
+		Name:    ContainerHelper,
+		Image:   helper.Image,
+		Command: helper.Command,
+		Args:    helper.Args,
+		Env:     buildContainerEnvVars(provider, helper.Env),
+	}
+
+	if helper.Resources != nil {
+		container.Resources = buildResourceRequirements(helper.Resources)
+	}
+
+	if helper.SecurityContext != nil {
+		container.SecurityContext = buildContainerSecurityContext(helper.SecurityContext)
+	} else {
+		container.SecurityContext = defaultContainerSecurityContext()
+	}
+
+	return container
+}
+
+// buildInitContainers assembles the Pod's init containers: native sidecars
+// (Sidecars with RestartPolicy "Always") first, so they're already running
+// for any init container that wants to talk to them, then the
+// operator-managed bundle-prefetch container (if InitPrefetch is set),
+// followed by the user-defined InitContainers in order.
+func buildInitContainers(provider *mcpv1alpha1.MCPProvider) []corev1.Container {
+	var containers []corev1.Container
+
+	for _, sidecar := range provider.Spec.Sidecars {
+		if sidecar.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+			containers = append(containers, buildSidecarContainer(provider, sidecar))
+		}
+	}
+
+	if provider.Spec.InitPrefetch != nil {
+		containers = append(containers, buildInitPrefetchContainer(provider, provider.Spec.InitPrefetch))
+	}
+
+	for _, initContainer := range provider.Spec.InitContainers {
+		containers = append(containers, buildInitContainer(provider, initContainer))
+	}
+
+	return containers
+}
+
+// buildInitContainer creates a user-defined init container, mounting only
+// the Volumes it lists in its own VolumeMounts.
+func buildInitContainer(provider *mcpv1alpha1.MCPProvider, initContainer mcpv1alpha1.InitContainerSpec) corev1.Container {
+	container := corev1.Container{
+		Name:         initContainer.Name,
+		Image:        initContainer.Image,
+		Command:      initContainer.Command,
+		Args:         initContainer.Args,
+		Env:          buildContainerEnvVars(provider, initContainer.Env),
+		VolumeMounts: buildVolumeMounts(initContainer.VolumeMounts),
+	}
+
+	if initContainer.Resources != nil {
+		container.Resources = buildResourceRequirements(initContainer.Resources)
+	}
+
+	if initContainer.SecurityContext != nil {
+		container.SecurityContext = buildContainerSecurityContext(initContainer.SecurityContext)
+	} else {
+		container.SecurityContext = defaultContainerSecurityContext()
+	}
+
+	return container
+}
+
+// buildInitPrefetchContainer creates the well-known init container that
+// pulls prefetch.BundleURLs into DefaultInitPrefetchTarget on the Volume
+// named by prefetch.Volume before any other container starts.
+func buildInitPrefetchContainer(provider *mcpv1alpha1.MCPProvider, prefetch *mcpv1alpha1.InitPrefetchSpec) corev1.Container {
+	image := prefetch.Image
+	if image == "" {
+		image = DefaultInitPrefetchImage
+	}
+
+	container := corev1.Container{
+		Name:  ContainerInitPrefetch,
+		Image: image,
+		Env: append(buildContainerEnvVars(provider, nil), corev1.EnvVar{
+			Name:  "MCP_BUNDLE_URLS",
+			Value: strings.Join(prefetch.BundleURLs, ","),
+		}),
+		VolumeMounts: buildVolumeMounts([]mcpv1alpha1.VolumeMount{
+			{Name: prefetch.Volume, MountPath: DefaultInitPrefetchTarget},
+		}),
+		SecurityContext: defaultContainerSecurityContext(),
+	}
+
+	return container
+}
+
+// buildVolumeMounts converts Sidecar/InitContainer VolumeMount references
+// into k8s VolumeMounts, letting a container mount a subset of the Volumes
+// the Pod already defines.
+func buildVolumeMounts(mounts []mcpv1alpha1.VolumeMount) []corev1.VolumeMount {
+	if len(mounts) == 0 {
+		return nil
+	}
+
+	result := make([]corev1.VolumeMount, len(mounts))
+	for i, m := range mounts {
+		result[i] = corev1.VolumeMount{
+			Name:      m.Name,
+			MountPath: m.MountPath,
+			SubPath:   m.SubPath,
+			ReadOnly:  m.ReadOnly,
+		}
+	}
+	return result
 }
 
 // buildContainer creates the main provider container
-func buildContainer(provider *mcpv1alpha1.MCPProvider) corev1.Container {
+func buildContainer(provider *mcpv1alpha1.MCPProvider) (corev1.Container, error) {
 	container := corev1.Container{
 		Name:            ContainerProvider,
 		Image:           provider.Spec.Image,
-		ImagePullPolicy: corev1.PullIfNotPresent,
+		ImagePullPolicy: imagePullPolicyOrDefault(provider.Spec.ImagePullPolicy),
 	}
 
 	// Command and args
@@ -112,7 +889,10 @@ func buildContainer(provider *mcpv1alpha1.MCPProvider) corev1.Container {
 		container.WorkingDir = provider.Spec.WorkingDir
 	}
 
-	// Environment variables
+	// Environment variables. EnvFrom is set first so the kubelet populates
+	// it into the container's environment before Env, letting a same-named
+	// Env entry override a value pulled in from a Secret/ConfigMap.
+	container.EnvFrom = buildEnvFromSources(provider.Spec.EnvFrom)
 	container.Env = buildEnvVars(provider)
 
 	// Resources
@@ -127,7 +907,248 @@ func buildContainer(provider *mcpv1alpha1.MCPProvider) corev1.Container {
 		container.SecurityContext = defaultContainerSecurityContext()
 	}
 
-	return container
+	// Probes
+	livenessProbe, err := buildProbe("livenessProbe", provider.Spec.LivenessProbe)
+	if err != nil {
+		return corev1.Container{}, err
+	}
+	container.LivenessProbe = livenessProbe
+
+	readinessProbe, err := buildProbe("readinessProbe", provider.Spec.ReadinessProbe)
+	if err != nil {
+		return corev1.Container{}, err
+	}
+	container.ReadinessProbe = readinessProbe
+
+	startupProbe, err := buildProbe("startupProbe", provider.Spec.StartupProbe)
+	if err != nil {
+		return corev1.Container{}, err
+	}
+	container.StartupProbe = startupProbe
+
+	lifecycle, err := buildLifecycle(provider.Spec.Lifecycle)
+	if err != nil {
+		return corev1.Container{}, err
+	}
+	container.Lifecycle = lifecycle
+
+	return container, nil
+}
+
+// buildLifecycle converts a Lifecycle spec into a corev1.Lifecycle. It
+// returns nil, nil when l has neither PostStart nor PreStop set.
+func buildLifecycle(l *mcpv1alpha1.Lifecycle) (*corev1.Lifecycle, error) {
+	if l == nil || (l.PostStart == nil && l.PreStop == nil) {
+		return nil, nil
+	}
+
+	lifecycle := &corev1.Lifecycle{}
+
+	if l.PostStart != nil {
+		postStart, err := buildLifecycleHandler("lifecycle.postStart", l.PostStart)
+		if err != nil {
+			return nil, err
+		}
+		lifecycle.PostStart = postStart
+	}
+
+	if l.PreStop != nil {
+		preStop, err := buildLifecycleHandler("lifecycle.preStop", l.PreStop)
+		if err != nil {
+			return nil, err
+		}
+		lifecycle.PreStop = preStop
+	}
+
+	return lifecycle, nil
+}
+
+// buildLifecycleHandler converts a LifecycleHandler spec into a
+// corev1.LifecycleHandler. name identifies the hook in error messages.
+func buildLifecycleHandler(name string, h *mcpv1alpha1.LifecycleHandler) (*corev1.LifecycleHandler, error) {
+	handler := &corev1.LifecycleHandler{}
+	handlers := 0
+
+	if h.Exec != nil {
+		handlers++
+		handler.Exec = &corev1.ExecAction{Command: h.Exec.Command}
+	}
+
+	if h.HTTPGet != nil {
+		handlers++
+		httpGet := &corev1.HTTPGetAction{
+			Path:   h.HTTPGet.Path,
+			Port:   intstr.FromInt32(h.HTTPGet.Port),
+			Host:   h.HTTPGet.Host,
+			Scheme: corev1.URIScheme(h.HTTPGet.Scheme),
+		}
+		for _, header := range h.HTTPGet.HTTPHeaders {
+			httpGet.HTTPHeaders = append(httpGet.HTTPHeaders, corev1.HTTPHeader{Name: header.Name, Value: header.Value})
+		}
+		handler.HTTPGet = httpGet
+	}
+
+	if handlers != 1 {
+		return nil, fmt.Errorf("%s: exactly one of exec or httpGet must be set", name)
+	}
+
+	return handler, nil
+}
+
+// buildProbe converts a Probe spec into a corev1.Probe. It returns nil, nil
+// when p is unset. A p with no handler set falls back to an MCPInitialize
+// probe with default settings, since the MCP wire protocol gives every
+// provider a ping-style health check for free. name identifies the probe
+// field in error messages.
+func buildProbe(name string, p *mcpv1alpha1.Probe) (*corev1.Probe, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	probe := &corev1.Probe{
+		InitialDelaySeconds: p.InitialDelaySeconds,
+		PeriodSeconds:       defaultInt32(p.PeriodSeconds, 10),
+		TimeoutSeconds:      defaultInt32(p.TimeoutSeconds, 1),
+		FailureThreshold:    defaultInt32(p.FailureThreshold, 3),
+		SuccessThreshold:    defaultInt32(p.SuccessThreshold, 1),
+	}
+
+	handlers := 0
+
+	if p.HTTPGet != nil {
+		handlers++
+		httpGet := &corev1.HTTPGetAction{
+			Path:   p.HTTPGet.Path,
+			Port:   intstr.FromInt32(p.HTTPGet.Port),
+			Host:   p.HTTPGet.Host,
+			Scheme: corev1.URIScheme(p.HTTPGet.Scheme),
+		}
+		for _, h := range p.HTTPGet.HTTPHeaders {
+			httpGet.HTTPHeaders = append(httpGet.HTTPHeaders, corev1.HTTPHeader{Name: h.Name, Value: h.Value})
+		}
+		probe.HTTPGet = httpGet
+	}
+
+	if p.TCPSocket != nil {
+		handlers++
+		probe.TCPSocket = &corev1.TCPSocketAction{
+			Port: intstr.FromInt32(p.TCPSocket.Port),
+			Host: p.TCPSocket.Host,
+		}
+	}
+
+	if p.GRPC != nil {
+		handlers++
+		probe.GRPC = &corev1.GRPCAction{
+			Port:    p.GRPC.Port,
+			Service: p.GRPC.Service,
+		}
+	}
+
+	if p.Exec != nil {
+		handlers++
+		probe.Exec = &corev1.ExecAction{Command: p.Exec.Command}
+	}
+
+	if p.MCPInitialize != nil {
+		handlers++
+		probe.Exec = &corev1.ExecAction{Command: buildMCPInitializeProbeCommand(p.MCPInitialize)}
+	}
+
+	if handlers == 0 {
+		probe.Exec = &corev1.ExecAction{Command: buildMCPInitializeProbeCommand(&mcpv1alpha1.MCPInitializeAction{})}
+		return probe, nil
+	}
+
+	if handlers != 1 {
+		return nil, fmt.Errorf("%s: at most one of httpGet, tcpSocket, grpc, exec, or mcpInitialize may be set", name)
+	}
+
+	return probe, nil
+}
+
+// buildMCPInitializeProbeCommand translates an MCPInitializeAction into an
+// invocation of the bundled probe binary, which sends an MCP `initialize`
+// JSON-RPC request over the given transport and exits non-zero unless the
+// response contains a `serverInfo` object.
+func buildMCPInitializeProbeCommand(action *mcpv1alpha1.MCPInitializeAction) []string {
+	transport := action.Transport
+	if transport == "" {
+		transport = DefaultMCPInitializeTransport
+	}
+
+	cmd := []string{MCPProbeBinaryPath, "--transport", transport}
+
+	if transport == "http" {
+		path := action.Path
+		if path == "" {
+			path = DefaultMCPInitializePath
+		}
+		cmd = append(cmd, "--port", strconv.Itoa(int(action.Port)), "--path", path)
+	}
+
+	return cmd
+}
+
+// defaultInt32 returns v, or def if v is the zero value.
+func defaultInt32(v, def int32) int32 {
+	if v == 0 {
+		return def
+	}
+	return v
+}
+
+// imagePullPolicyOrDefault returns policy, or PullIfNotPresent if it's unset.
+func imagePullPolicyOrDefault(policy corev1.PullPolicy) corev1.PullPolicy {
+	if policy == "" {
+		return corev1.PullIfNotPresent
+	}
+	return policy
+}
+
+// ImagePullCredentialsSecretName returns the name of the dockerconfigjson
+// Secret BuildImagePullSecret materializes for provider, when
+// Spec.ImagePullCredentials.ConfigMapRef is set.
+func ImagePullCredentialsSecretName(provider *mcpv1alpha1.MCPProvider) string {
+	return provider.Name + ImagePullCredentialsSecretSuffix
+}
+
+// buildImagePullSecrets returns the ImagePullSecrets list for the Pod,
+// appending references to the provider's generated credentials Secret
+// alongside any Spec.ImagePullSecrets and Spec.ImagePullCredentials.SecretRef.
+func buildImagePullSecrets(provider *mcpv1alpha1.MCPProvider) []corev1.LocalObjectReference {
+	secrets := provider.Spec.ImagePullSecrets
+
+	creds := provider.Spec.ImagePullCredentials
+	if creds == nil {
+		return secrets
+	}
+	if creds.SecretRef != nil {
+		secrets = append(secrets, *creds.SecretRef)
+	}
+	if creds.ConfigMapRef != nil {
+		secrets = append(secrets, corev1.LocalObjectReference{Name: ImagePullCredentialsSecretName(provider)})
+	}
+	return secrets
+}
+
+// BuildImagePullSecret materializes the kubernetes.io/dockerconfigjson
+// Secret for provider from the raw {"auths": {...}} document read out of
+// Spec.ImagePullCredentials.ConfigMapRef. The caller (the reconciler, which
+// owns Secret creation and owner-reference wiring) is responsible for
+// reading the source ConfigMap and passing its resolved value.
+func BuildImagePullSecret(provider *mcpv1alpha1.MCPProvider, dockerConfigJSON []byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ImagePullCredentialsSecretName(provider),
+			Namespace: provider.Namespace,
+			Labels:    buildLabels(provider),
+		},
+		Type: corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: dockerConfigJSON,
+		},
+	}
 }
 
 // buildLabels creates standard labels for provider resources
@@ -149,9 +1170,54 @@ func buildLabels(provider *mcpv1alpha1.MCPProvider) map[string]string {
 	return labels
 }
 
-// buildEnvVars creates environment variables from provider spec
-func buildEnvVars(provider *mcpv1alpha1.MCPProvider) []corev1.EnvVar {
-	envVars := []corev1.EnvVar{
+// buildAppArmorAnnotations returns the Pod-level annotations that assign an
+// AppArmor profile to each container that declares one, since AppArmor is
+// configured via annotation rather than a SecurityContext field.
+func buildAppArmorAnnotations(provider *mcpv1alpha1.MCPProvider) map[string]string {
+	annotations := map[string]string{}
+
+	addAppArmorAnnotation(annotations, ContainerProvider, provider.Spec.SecurityContext)
+	for _, sidecar := range provider.Spec.Sidecars {
+		addAppArmorAnnotation(annotations, sidecar.Name, sidecar.SecurityContext)
+	}
+	if provider.Spec.Helper != nil {
+		addAppArmorAnnotation(annotations, ContainerHelper, provider.Spec.Helper.SecurityContext)
+	}
+	for _, initContainer := range provider.Spec.InitContainers {
+		addAppArmorAnnotation(annotations, initContainer.Name, initContainer.SecurityContext)
+	}
+
+	return annotations
+}
+
+// addAppArmorAnnotation sets containerName's AppArmor annotation on
+// annotations if sc declares an AppArmorProfile.
+func addAppArmorAnnotation(annotations map[string]string, containerName string, sc *mcpv1alpha1.SecurityContext) {
+	if sc == nil || sc.AppArmorProfile == nil {
+		return
+	}
+	annotations[AppArmorAnnotationPrefix+containerName] = appArmorProfileValue(sc.AppArmorProfile)
+}
+
+// appArmorProfileValue renders an AppArmorProfile as the value the kubelet
+// expects on the container.apparmor.security.beta.kubernetes.io/<name>
+// annotation.
+func appArmorProfileValue(p *mcpv1alpha1.AppArmorProfile) string {
+	switch p.Type {
+	case "Localhost":
+		return "localhost/" + p.LocalhostProfile
+	case "Unconfined":
+		return "unconfined"
+	default:
+		return "runtime/default"
+	}
+}
+
+// sharedEnvVars returns the MCP_PROVIDER_*/MCP_POD_* variables every
+// container in the pod receives, so a sidecar or the helper can address the
+// same provider identity as the main container.
+func sharedEnvVars(provider *mcpv1alpha1.MCPProvider) []corev1.EnvVar {
+	return []corev1.EnvVar{
 		{
 			Name:  "MCP_PROVIDER_NAME",
 			Value: provider.Name,
@@ -177,9 +1243,15 @@ func buildEnvVars(provider *mcpv1alpha1.MCPProvider) []corev1.EnvVar {
 			},
 		},
 	}
+}
 
-	// Add user-defined env vars
-	for _, env := range provider.Spec.Env {
+// buildContainerEnvVars creates the environment variables for a single
+// container: the shared MCP_PROVIDER_*/MCP_POD_* vars every container in
+// the pod gets, plus that container's own user-defined vars.
+func buildContainerEnvVars(provider *mcpv1alpha1.MCPProvider, envs []mcpv1alpha1.EnvVar) []corev1.EnvVar {
+	envVars := sharedEnvVars(provider)
+
+	for _, env := range envs {
 		envVar := corev1.EnvVar{
 			Name: env.Name,
 		}
@@ -196,6 +1268,43 @@ func buildEnvVars(provider *mcpv1alpha1.MCPProvider) []corev1.EnvVar {
 	return envVars
 }
 
+// buildEnvVars creates environment variables for the main provider
+// container from provider spec
+func buildEnvVars(provider *mcpv1alpha1.MCPProvider) []corev1.EnvVar {
+	return buildContainerEnvVars(provider, provider.Spec.Env)
+}
+
+// buildEnvFromSources converts our EnvFromSource list to k8s EnvFromSource,
+// preserving order since earlier sources' keys are overridden by later ones.
+func buildEnvFromSources(sources []mcpv1alpha1.EnvFromSource) []corev1.EnvFromSource {
+	if len(sources) == 0 {
+		return nil
+	}
+
+	result := make([]corev1.EnvFromSource, len(sources))
+	for i, source := range sources {
+		envFrom := corev1.EnvFromSource{Prefix: source.Prefix}
+
+		if source.SecretRef != nil {
+			envFrom.SecretRef = &corev1.SecretEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: source.SecretRef.Name},
+				Optional:             source.SecretRef.Optional,
+			}
+		}
+
+		if source.ConfigMapRef != nil {
+			envFrom.ConfigMapRef = &corev1.ConfigMapEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: source.ConfigMapRef.Name},
+				Optional:             source.ConfigMapRef.Optional,
+			}
+		}
+
+		result[i] = envFrom
+	}
+
+	return result
+}
+
 // buildEnvVarSource converts our EnvVarSource to k8s EnvVarSource
 func buildEnvVarSource(source *mcpv1alpha1.EnvVarSource) *corev1.EnvVarSource {
 	if source == nil {
@@ -224,9 +1333,36 @@ func buildEnvVarSource(source *mcpv1alpha1.EnvVarSource) *corev1.EnvVarSource {
 		}
 	}
 
+	if source.FieldRef != nil {
+		result.FieldRef = buildObjectFieldSelector(source.FieldRef)
+	}
+
+	if source.ResourceFieldRef != nil {
+		result.ResourceFieldRef = buildResourceFieldSelector(source.ResourceFieldRef)
+	}
+
 	return result
 }
 
+// buildObjectFieldSelector converts our ObjectFieldSelector to k8s ObjectFieldSelector
+func buildObjectFieldSelector(ref *mcpv1alpha1.ObjectFieldSelector) *corev1.ObjectFieldSelector {
+	return &corev1.ObjectFieldSelector{
+		FieldPath: ref.FieldPath,
+	}
+}
+
+// buildResourceFieldSelector converts our ResourceFieldSelector to k8s ResourceFieldSelector
+func buildResourceFieldSelector(ref *mcpv1alpha1.ResourceFieldSelector) *corev1.ResourceFieldSelector {
+	selector := &corev1.ResourceFieldSelector{
+		ContainerName: ref.ContainerName,
+		Resource:      ref.Resource,
+	}
+	if ref.Divisor != "" {
+		selector.Divisor = resource.MustParse(ref.Divisor)
+	}
+	return selector
+}
+
 // buildResourceRequirements converts our ResourceRequirements to k8s ResourceRequirements
 func buildResourceRequirements(res *mcpv1alpha1.ResourceRequirements) corev1.ResourceRequirements {
 	requirements := corev1.ResourceRequirements{}
@@ -255,7 +1391,7 @@ func buildResourceRequirements(res *mcpv1alpha1.ResourceRequirements) corev1.Res
 }
 
 // buildVolumes creates volume mounts and volumes from provider spec
-func buildVolumes(provider *mcpv1alpha1.MCPProvider) ([]corev1.VolumeMount, []corev1.Volume) {
+func buildVolumes(provider *mcpv1alpha1.MCPProvider, options *buildOptions) ([]corev1.VolumeMount, []corev1.Volume, error) {
 	var mounts []corev1.VolumeMount
 	var volumes []corev1.Volume
 
@@ -306,12 +1442,55 @@ func buildVolumes(provider *mcpv1alpha1.MCPProvider) ([]corev1.VolumeMount, []co
 			volume.VolumeSource = corev1.VolumeSource{
 				EmptyDir: emptyDir,
 			}
+		} else if vol.Ephemeral != nil {
+			if options.genericEphemeralVolumesDisabled {
+				return nil, nil, fmt.Errorf("volume %q: ephemeral volumes require the GenericEphemeralVolume feature gate, which is disabled on this cluster", vol.Name)
+			}
+
+			tmpl := vol.Ephemeral.VolumeClaimTemplate
+			accessModes := make([]corev1.PersistentVolumeAccessMode, len(tmpl.AccessModes))
+			for i, mode := range tmpl.AccessModes {
+				accessModes[i] = corev1.PersistentVolumeAccessMode(mode)
+			}
+
+			volume.VolumeSource = corev1.VolumeSource{
+				Ephemeral: &corev1.EphemeralVolumeSource{
+					VolumeClaimTemplate: &corev1.PersistentVolumeClaimTemplate{
+						Spec: corev1.PersistentVolumeClaimSpec{
+							StorageClassName: tmpl.StorageClassName,
+							AccessModes:      accessModes,
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceStorage: resource.MustParse(tmpl.Storage),
+								},
+							},
+						},
+					},
+				},
+			}
+		} else if vol.DownwardAPI != nil {
+			items := make([]corev1.DownwardAPIVolumeFile, len(vol.DownwardAPI.Items))
+			for i, item := range vol.DownwardAPI.Items {
+				file := corev1.DownwardAPIVolumeFile{Path: item.Path}
+				if item.FieldRef != nil {
+					file.FieldRef = buildObjectFieldSelector(item.FieldRef)
+				}
+				if item.ResourceFieldRef != nil {
+					file.ResourceFieldRef = buildResourceFieldSelector(item.ResourceFieldRef)
+				}
+				items[i] = file
+			}
+			volume.VolumeSource = corev1.VolumeSource{
+				DownwardAPI: &corev1.DownwardAPIVolumeSource{
+					Items: items,
+				},
+			}
 		}
 
 		volumes = append(volumes, volume)
 	}
 
-	return mounts, volumes
+	return mounts, volumes, nil
 }
 
 // buildKeyToPath converts our KeyToPath to k8s KeyToPath
@@ -362,9 +1541,10 @@ func buildPodSecurityContext(sc *mcpv1alpha1.SecurityContext) *corev1.PodSecurit
 		ctx.FSGroup = sc.FSGroup
 	}
 	if sc.SeccompProfile != nil {
-		ctx.SeccompProfile = &corev1.SeccompProfile{
-			Type: corev1.SeccompProfileType(sc.SeccompProfile.Type),
-		}
+		ctx.SeccompProfile = buildSeccompProfile(sc.SeccompProfile)
+	}
+	if sc.SELinuxOptions != nil {
+		ctx.SELinuxOptions = buildSELinuxOptions(sc.SELinuxOptions)
 	}
 
 	return ctx
@@ -399,14 +1579,37 @@ func buildContainerSecurityContext(sc *mcpv1alpha1.SecurityContext) *corev1.Secu
 		}
 	}
 	if sc.SeccompProfile != nil {
-		ctx.SeccompProfile = &corev1.SeccompProfile{
-			Type: corev1.SeccompProfileType(sc.SeccompProfile.Type),
-		}
+		ctx.SeccompProfile = buildSeccompProfile(sc.SeccompProfile)
+	}
+	if sc.SELinuxOptions != nil {
+		ctx.SELinuxOptions = buildSELinuxOptions(sc.SELinuxOptions)
 	}
 
 	return ctx
 }
 
+// buildSeccompProfile converts a SeccompProfile spec into a
+// corev1.SeccompProfile, carrying LocalhostProfile along for the Localhost
+// type.
+func buildSeccompProfile(sc *mcpv1alpha1.SeccompProfile) *corev1.SeccompProfile {
+	profile := &corev1.SeccompProfile{Type: corev1.SeccompProfileType(sc.Type)}
+	if sc.LocalhostProfile != "" {
+		profile.LocalhostProfile = &sc.LocalhostProfile
+	}
+	return profile
+}
+
+// buildSELinuxOptions converts a SELinuxOptions spec into a
+// corev1.SELinuxOptions.
+func buildSELinuxOptions(sc *mcpv1alpha1.SELinuxOptions) *corev1.SELinuxOptions {
+	return &corev1.SELinuxOptions{
+		User:  sc.User,
+		Role:  sc.Role,
+		Type:  sc.Type,
+		Level: sc.Level,
+	}
+}
+
 // defaultPodSecurityContext returns secure default pod security context
 func defaultPodSecurityContext() *corev1.PodSecurityContext {
 	runAsNonRoot := true
@@ -442,16 +1645,57 @@ func defaultContainerSecurityContext() *corev1.SecurityContext {
 	}
 }
 
-// getTerminationGracePeriod returns termination grace period in seconds
+// getTerminationGracePeriod parses Spec.ShutdownGracePeriod ("30s", "2m",
+// "1h30m", ...) into TerminationGracePeriodSeconds, rounding up to the next
+// whole second and clamping to MaxTerminationGracePeriodSeconds. An empty
+// or unparseable value falls back to DefaultTerminationGracePeriodSeconds.
 func getTerminationGracePeriod(provider *mcpv1alpha1.MCPProvider) *int64 {
-	// Default 30 seconds
-	defaultGrace := int64(30)
+	defaultGrace := DefaultTerminationGracePeriodSeconds
 
 	if provider.Spec.ShutdownGracePeriod == "" {
 		return &defaultGrace
 	}
 
-	// Parse duration (simplified - just handle seconds for now)
-	// Full implementation would parse "30s", "1m", etc.
-	return &defaultGrace
+	d, err := time.ParseDuration(provider.Spec.ShutdownGracePeriod)
+	if err != nil || d <= 0 {
+		return &defaultGrace
+	}
+
+	seconds := int64(math.Ceil(d.Seconds()))
+	if seconds > MaxTerminationGracePeriodSeconds {
+		seconds = MaxTerminationGracePeriodSeconds
+	}
+
+	return &seconds
+}
+
+// LifecyclePreStopTimeoutWarning returns a non-empty warning message when
+// Spec.Lifecycle.PreStop declares a TimeoutSeconds longer than the pod's
+// termination grace period, since the kubelet sends SIGKILL once the grace
+// period elapses regardless of whether the hook has finished.
+func LifecyclePreStopTimeoutWarning(provider *mcpv1alpha1.MCPProvider) string {
+	if provider.Spec.Lifecycle == nil || provider.Spec.Lifecycle.PreStop == nil {
+		return ""
+	}
+
+	timeout := provider.Spec.Lifecycle.PreStop.TimeoutSeconds
+	if timeout <= 0 {
+		return ""
+	}
+
+	grace := getTerminationGracePeriod(provider)
+	if int64(timeout) <= *grace {
+		return ""
+	}
+
+	return fmt.Sprintf("preStop.timeoutSeconds (%ds) exceeds shutdownGracePeriod (%ds); the hook may be killed before it finishes", timeout, *grace)
+}
+
+// TerminationGracePeriodSeconds exports getTerminationGracePeriod's
+// Spec.ShutdownGracePeriod parsing so the controller can pass the same
+// grace period to client.GracePeriodSeconds when it deletes a Pod, instead
+// of letting the delete fall back to whatever grace the API server
+// defaults to.
+func TerminationGracePeriodSeconds(provider *mcpv1alpha1.MCPProvider) int64 {
+	return *getTerminationGracePeriod(provider)
 }
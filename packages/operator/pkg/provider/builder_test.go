@@ -1,10 +1,12 @@
 package provider
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,6 +14,21 @@ import (
 	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
 )
 
+// buildPod calls BuildWorkloadForProvider and asserts the result is a Pod,
+// which is the workload kind exercised by most of this file's tests.
+func buildPod(t *testing.T, provider *mcpv1alpha1.MCPProvider, opts ...BuildOption) (*corev1.Pod, error) {
+	t.Helper()
+
+	obj, err := BuildWorkloadForProvider(provider, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	pod, ok := obj.(*corev1.Pod)
+	require.True(t, ok, "expected *corev1.Pod, got %T", obj)
+	return pod, nil
+}
+
 func TestBuildPodForProvider_BasicContainer(t *testing.T) {
 	provider := &mcpv1alpha1.MCPProvider{
 		ObjectMeta: metav1.ObjectMeta{
@@ -25,7 +42,7 @@ func TestBuildPodForProvider_BasicContainer(t *testing.T) {
 		},
 	}
 
-	pod, err := BuildPodForProvider(provider)
+	pod, err := buildPod(t, provider)
 
 	require.NoError(t, err)
 	assert.NotNil(t, pod)
@@ -47,7 +64,7 @@ func TestBuildPodForProvider_NoImage(t *testing.T) {
 		},
 	}
 
-	pod, err := BuildPodForProvider(provider)
+	pod, err := buildPod(t, provider)
 
 	assert.Error(t, err)
 	assert.Nil(t, pod)
@@ -76,7 +93,7 @@ func TestBuildPodForProvider_WithResources(t *testing.T) {
 		},
 	}
 
-	pod, err := BuildPodForProvider(provider)
+	pod, err := buildPod(t, provider)
 
 	require.NoError(t, err)
 	container := pod.Spec.Containers[0]
@@ -114,7 +131,7 @@ func TestBuildPodForProvider_WithEnvVars(t *testing.T) {
 		},
 	}
 
-	pod, err := BuildPodForProvider(provider)
+	pod, err := buildPod(t, provider)
 
 	require.NoError(t, err)
 	envVars := pod.Spec.Containers[0].Env
@@ -139,6 +156,45 @@ func TestBuildPodForProvider_WithEnvVars(t *testing.T) {
 	assert.Equal(t, "password", secretVar.ValueFrom.SecretKeyRef.Key)
 }
 
+func TestBuildPodForProvider_WithEnvFrom(t *testing.T) {
+	optional := true
+
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			EnvFrom: []mcpv1alpha1.EnvFromSource{
+				{
+					Prefix:       "API_",
+					ConfigMapRef: &mcpv1alpha1.ConfigMapEnvSource{Name: "provider-config"},
+				},
+				{
+					SecretRef: &mcpv1alpha1.SecretEnvSource{Name: "provider-secrets", Optional: &optional},
+				},
+			},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	envFrom := pod.Spec.Containers[0].EnvFrom
+	require.Len(t, envFrom, 2)
+
+	assert.Equal(t, "API_", envFrom[0].Prefix)
+	require.NotNil(t, envFrom[0].ConfigMapRef)
+	assert.Equal(t, "provider-config", envFrom[0].ConfigMapRef.Name)
+
+	require.NotNil(t, envFrom[1].SecretRef)
+	assert.Equal(t, "provider-secrets", envFrom[1].SecretRef.Name)
+	require.NotNil(t, envFrom[1].SecretRef.Optional)
+	assert.True(t, *envFrom[1].SecretRef.Optional)
+}
+
 func TestBuildPodForProvider_WithVolumes(t *testing.T) {
 	provider := &mcpv1alpha1.MCPProvider{
 		ObjectMeta: metav1.ObjectMeta{
@@ -169,7 +225,7 @@ func TestBuildPodForProvider_WithVolumes(t *testing.T) {
 		},
 	}
 
-	pod, err := BuildPodForProvider(provider)
+	pod, err := buildPod(t, provider)
 
 	require.NoError(t, err)
 	assert.Len(t, pod.Spec.Volumes, 2)
@@ -199,6 +255,180 @@ func TestBuildPodForProvider_WithVolumes(t *testing.T) {
 	assert.True(t, configMount.ReadOnly)
 }
 
+func TestBuildPodForProvider_WithEphemeralVolume(t *testing.T) {
+	storageClass := "fast-ssd"
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			Volumes: []mcpv1alpha1.Volume{
+				{
+					Name:      "scratch",
+					MountPath: "/scratch",
+					Ephemeral: &mcpv1alpha1.EphemeralVolumeSource{
+						VolumeClaimTemplate: mcpv1alpha1.EphemeralVolumeClaimTemplate{
+							StorageClassName: &storageClass,
+							AccessModes:      []string{"ReadWriteOnce"},
+							Storage:          "5Gi",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	require.Len(t, pod.Spec.Volumes, 1)
+
+	volume := findVolume(pod.Spec.Volumes, "scratch")
+	require.NotNil(t, volume)
+	require.NotNil(t, volume.Ephemeral)
+	require.NotNil(t, volume.Ephemeral.VolumeClaimTemplate)
+
+	spec := volume.Ephemeral.VolumeClaimTemplate.Spec
+	require.NotNil(t, spec.StorageClassName)
+	assert.Equal(t, "fast-ssd", *spec.StorageClassName)
+	assert.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}, spec.AccessModes)
+	assert.Equal(t, "5Gi", spec.Resources.Requests.Storage().String())
+}
+
+func TestBuildPodForProvider_EphemeralVolume_RejectedWhenFeatureGateDisabled(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			Volumes: []mcpv1alpha1.Volume{
+				{
+					Name:      "scratch",
+					MountPath: "/scratch",
+					Ephemeral: &mcpv1alpha1.EphemeralVolumeSource{
+						VolumeClaimTemplate: mcpv1alpha1.EphemeralVolumeClaimTemplate{
+							AccessModes: []string{"ReadWriteOnce"},
+							Storage:     "5Gi",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	_, err := buildPod(t, provider, WithGenericEphemeralVolumesDisabled())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "GenericEphemeralVolume")
+}
+
+func TestBuildPodForProvider_WithDownwardAPIEnvVar(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			Env: []mcpv1alpha1.EnvVar{
+				{
+					Name: "SELF_POD_IP",
+					ValueFrom: &mcpv1alpha1.EnvVarSource{
+						FieldRef: &mcpv1alpha1.ObjectFieldSelector{
+							FieldPath: "status.podIP",
+						},
+					},
+				},
+				{
+					Name: "MEMORY_LIMIT",
+					ValueFrom: &mcpv1alpha1.EnvVarSource{
+						ResourceFieldRef: &mcpv1alpha1.ResourceFieldSelector{
+							Resource: "limits.memory",
+							Divisor:  "1Mi",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	envVars := pod.Spec.Containers[0].Env
+
+	podIPVar := findEnvVar(envVars, "SELF_POD_IP")
+	require.NotNil(t, podIPVar)
+	require.NotNil(t, podIPVar.ValueFrom)
+	require.NotNil(t, podIPVar.ValueFrom.FieldRef)
+	assert.Equal(t, "status.podIP", podIPVar.ValueFrom.FieldRef.FieldPath)
+
+	memVar := findEnvVar(envVars, "MEMORY_LIMIT")
+	require.NotNil(t, memVar)
+	require.NotNil(t, memVar.ValueFrom)
+	require.NotNil(t, memVar.ValueFrom.ResourceFieldRef)
+	assert.Equal(t, "limits.memory", memVar.ValueFrom.ResourceFieldRef.Resource)
+	assert.Equal(t, "1Mi", memVar.ValueFrom.ResourceFieldRef.Divisor.String())
+}
+
+func TestBuildPodForProvider_WithDownwardAPIVolume(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			Volumes: []mcpv1alpha1.Volume{
+				{
+					Name:      "pod-info",
+					MountPath: "/etc/pod-info",
+					DownwardAPI: &mcpv1alpha1.DownwardAPIVolumeSource{
+						Items: []mcpv1alpha1.DownwardAPIVolumeFile{
+							{
+								Path: "name",
+								FieldRef: &mcpv1alpha1.ObjectFieldSelector{
+									FieldPath: "metadata.name",
+								},
+							},
+							{
+								Path: "cpu_limit",
+								ResourceFieldRef: &mcpv1alpha1.ResourceFieldSelector{
+									Resource: "limits.cpu",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	volume := findVolume(pod.Spec.Volumes, "pod-info")
+	require.NotNil(t, volume)
+	require.NotNil(t, volume.DownwardAPI)
+	require.Len(t, volume.DownwardAPI.Items, 2)
+
+	assert.Equal(t, "name", volume.DownwardAPI.Items[0].Path)
+	require.NotNil(t, volume.DownwardAPI.Items[0].FieldRef)
+	assert.Equal(t, "metadata.name", volume.DownwardAPI.Items[0].FieldRef.FieldPath)
+
+	assert.Equal(t, "cpu_limit", volume.DownwardAPI.Items[1].Path)
+	require.NotNil(t, volume.DownwardAPI.Items[1].ResourceFieldRef)
+	assert.Equal(t, "limits.cpu", volume.DownwardAPI.Items[1].ResourceFieldRef.Resource)
+}
+
 func TestBuildPodForProvider_WithSecurityContext(t *testing.T) {
 	runAsUser := int64(1000)
 	runAsNonRoot := true
@@ -226,7 +456,7 @@ func TestBuildPodForProvider_WithSecurityContext(t *testing.T) {
 		},
 	}
 
-	pod, err := BuildPodForProvider(provider)
+	pod, err := buildPod(t, provider)
 
 	require.NoError(t, err)
 	secCtx := pod.Spec.Containers[0].SecurityContext
@@ -242,6 +472,79 @@ func TestBuildPodForProvider_WithSecurityContext(t *testing.T) {
 	assert.Contains(t, secCtx.Capabilities.Add, corev1.Capability("NET_BIND_SERVICE"))
 }
 
+func TestBuildPodForProvider_WithSELinuxAndLocalhostSeccomp(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			SecurityContext: &mcpv1alpha1.SecurityContext{
+				SELinuxOptions: &mcpv1alpha1.SELinuxOptions{
+					Type:  "container_t",
+					Level: "s0:c123,c456",
+				},
+				SeccompProfile: &mcpv1alpha1.SeccompProfile{
+					Type:             "Localhost",
+					LocalhostProfile: "profiles/mcp-provider.json",
+				},
+			},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+
+	podSecCtx := pod.Spec.SecurityContext
+	require.NotNil(t, podSecCtx)
+	require.NotNil(t, podSecCtx.SELinuxOptions)
+	assert.Equal(t, "container_t", podSecCtx.SELinuxOptions.Type)
+	assert.Equal(t, "s0:c123,c456", podSecCtx.SELinuxOptions.Level)
+	require.NotNil(t, podSecCtx.SeccompProfile)
+	assert.Equal(t, corev1.SeccompProfileTypeLocalhost, podSecCtx.SeccompProfile.Type)
+	require.NotNil(t, podSecCtx.SeccompProfile.LocalhostProfile)
+	assert.Equal(t, "profiles/mcp-provider.json", *podSecCtx.SeccompProfile.LocalhostProfile)
+
+	containerSecCtx := pod.Spec.Containers[0].SecurityContext
+	require.NotNil(t, containerSecCtx)
+	require.NotNil(t, containerSecCtx.SELinuxOptions)
+	assert.Equal(t, "container_t", containerSecCtx.SELinuxOptions.Type)
+}
+
+func TestBuildPodForProvider_WithAppArmorProfile(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			SecurityContext: &mcpv1alpha1.SecurityContext{
+				AppArmorProfile: &mcpv1alpha1.AppArmorProfile{
+					Type:             "Localhost",
+					LocalhostProfile: "k8s-mcp-provider",
+				},
+			},
+			Helper: &mcpv1alpha1.HelperSpec{
+				Image: "helper:latest",
+				SecurityContext: &mcpv1alpha1.SecurityContext{
+					AppArmorProfile: &mcpv1alpha1.AppArmorProfile{Type: "Unconfined"},
+				},
+			},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	assert.Equal(t, "localhost/k8s-mcp-provider", pod.Annotations[AppArmorAnnotationPrefix+ContainerProvider])
+	assert.Equal(t, "unconfined", pod.Annotations[AppArmorAnnotationPrefix+ContainerHelper])
+}
+
 func TestBuildPodForProvider_WithDefaultSecurityContext(t *testing.T) {
 	provider := &mcpv1alpha1.MCPProvider{
 		ObjectMeta: metav1.ObjectMeta{
@@ -255,7 +558,7 @@ func TestBuildPodForProvider_WithDefaultSecurityContext(t *testing.T) {
 		},
 	}
 
-	pod, err := BuildPodForProvider(provider)
+	pod, err := buildPod(t, provider)
 
 	require.NoError(t, err)
 
@@ -270,30 +573,173 @@ func TestBuildPodForProvider_WithDefaultSecurityContext(t *testing.T) {
 	assert.Contains(t, secCtx.Capabilities.Drop, corev1.Capability("ALL"))
 }
 
-func TestBuildPodForProvider_WithCommandAndArgs(t *testing.T) {
+func TestBuildPodForProvider_SecurityProfile(t *testing.T) {
+	trueVal := true
+	falseVal := false
+
+	tests := []struct {
+		name            string
+		profile         mcpv1alpha1.SecurityProfile
+		securityContext *mcpv1alpha1.SecurityContext
+		wantErr         bool
+		wantViolation   string
+	}{
+		{
+			name:    "restricted accepts the builder's secure defaults",
+			profile: mcpv1alpha1.SecurityProfileRestricted,
+			wantErr: false,
+		},
+		{
+			name:    "baseline accepts the builder's secure defaults",
+			profile: mcpv1alpha1.SecurityProfileBaseline,
+			wantErr: false,
+		},
+		{
+			name:    "privileged applies no gating",
+			profile: mcpv1alpha1.SecurityProfilePrivileged,
+			securityContext: &mcpv1alpha1.SecurityContext{
+				RunAsNonRoot: &falseVal,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "no profile applies no gating",
+			profile: "",
+			securityContext: &mcpv1alpha1.SecurityContext{
+				RunAsNonRoot: &falseVal,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "restricted rejects runAsNonRoot false",
+			profile: mcpv1alpha1.SecurityProfileRestricted,
+			securityContext: &mcpv1alpha1.SecurityContext{
+				RunAsNonRoot:             &falseVal,
+				ReadOnlyRootFilesystem:   &trueVal,
+				AllowPrivilegeEscalation: &falseVal,
+				Capabilities:             &mcpv1alpha1.Capabilities{Drop: []string{"ALL"}},
+				SeccompProfile:           &mcpv1alpha1.SeccompProfile{Type: "RuntimeDefault"},
+			},
+			wantErr:       true,
+			wantViolation: "runAsNonRoot must be true",
+		},
+		{
+			name:    "restricted rejects a capability add beyond NET_BIND_SERVICE",
+			profile: mcpv1alpha1.SecurityProfileRestricted,
+			securityContext: &mcpv1alpha1.SecurityContext{
+				RunAsNonRoot:             &trueVal,
+				ReadOnlyRootFilesystem:   &trueVal,
+				AllowPrivilegeEscalation: &falseVal,
+				Capabilities:             &mcpv1alpha1.Capabilities{Drop: []string{"ALL"}, Add: []string{"SYS_ADMIN"}},
+				SeccompProfile:           &mcpv1alpha1.SeccompProfile{Type: "RuntimeDefault"},
+			},
+			wantErr:       true,
+			wantViolation: `capabilities.add "SYS_ADMIN" is not allowed`,
+		},
+		{
+			name:    "restricted rejects a missing seccomp profile",
+			profile: mcpv1alpha1.SecurityProfileRestricted,
+			securityContext: &mcpv1alpha1.SecurityContext{
+				RunAsNonRoot:             &trueVal,
+				ReadOnlyRootFilesystem:   &trueVal,
+				AllowPrivilegeEscalation: &falseVal,
+				Capabilities:             &mcpv1alpha1.Capabilities{Drop: []string{"ALL"}},
+			},
+			wantErr:       true,
+			wantViolation: "seccompProfile.type must be RuntimeDefault or Localhost",
+		},
+		{
+			name:    "baseline rejects a capability add outside its allow-list",
+			profile: mcpv1alpha1.SecurityProfileBaseline,
+			securityContext: &mcpv1alpha1.SecurityContext{
+				Capabilities: &mcpv1alpha1.Capabilities{Add: []string{"SYS_ADMIN"}},
+			},
+			wantErr:       true,
+			wantViolation: `capabilities.add "SYS_ADMIN" is not allowed`,
+		},
+		{
+			name:    "baseline accepts a capability add within its allow-list",
+			profile: mcpv1alpha1.SecurityProfileBaseline,
+			securityContext: &mcpv1alpha1.SecurityContext{
+				Capabilities: &mcpv1alpha1.Capabilities{Add: []string{"CHOWN"}},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "unknown profile is rejected",
+			profile: "made-up",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &mcpv1alpha1.MCPProvider{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-provider",
+					Namespace: "default",
+				},
+				Spec: mcpv1alpha1.MCPProviderSpec{
+					Mode:            "container",
+					Image:           "test-image:latest",
+					SecurityProfile: tt.profile,
+					SecurityContext: tt.securityContext,
+				},
+			}
+
+			pod, err := buildPod(t, provider)
+
+			if !tt.wantErr {
+				require.NoError(t, err)
+				assert.NotNil(t, pod)
+				return
+			}
+
+			require.Error(t, err)
+			assert.Nil(t, pod)
+			if tt.wantViolation != "" {
+				assert.Contains(t, err.Error(), tt.wantViolation)
+			}
+		})
+	}
+}
+
+func TestBuildPodForProvider_SecurityProfile_ListsEveryViolation(t *testing.T) {
+	falseVal := false
+
 	provider := &mcpv1alpha1.MCPProvider{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "test-provider",
 			Namespace: "default",
 		},
 		Spec: mcpv1alpha1.MCPProviderSpec{
-			Mode:    "container",
-			Image:   "test-image:latest",
-			Command: []string{"/app/provider"},
-			Args:    []string{"--config", "/config/app.yaml", "--verbose"},
+			Mode:            "container",
+			Image:           "test-image:latest",
+			SecurityProfile: mcpv1alpha1.SecurityProfileRestricted,
+			SecurityContext: &mcpv1alpha1.SecurityContext{
+				RunAsNonRoot:             &falseVal,
+				ReadOnlyRootFilesystem:   &falseVal,
+				AllowPrivilegeEscalation: &falseVal,
+			},
 		},
 	}
 
-	pod, err := BuildPodForProvider(provider)
+	pod, err := buildPod(t, provider)
 
-	require.NoError(t, err)
-	container := pod.Spec.Containers[0]
+	require.Error(t, err)
+	assert.Nil(t, pod)
 
-	assert.Equal(t, []string{"/app/provider"}, container.Command)
-	assert.Equal(t, []string{"--config", "/config/app.yaml", "--verbose"}, container.Args)
+	var pssErr *PodSecurityStandardError
+	require.ErrorAs(t, err, &pssErr)
+	assert.Contains(t, pssErr.Error(), "runAsNonRoot must be true")
+	assert.Contains(t, pssErr.Error(), "readOnlyRootFilesystem must be true")
+	assert.Contains(t, pssErr.Error(), "capabilities.drop must include ALL")
+	assert.Contains(t, pssErr.Error(), "seccompProfile.type must be RuntimeDefault or Localhost")
 }
 
-func TestBuildPodForProvider_WithNodeSelector(t *testing.T) {
+func TestBuildPodForProvider_WithProbes(t *testing.T) {
+	grpcService := "mcp.Provider"
+
 	provider := &mcpv1alpha1.MCPProvider{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "test-provider",
@@ -302,21 +748,55 @@ func TestBuildPodForProvider_WithNodeSelector(t *testing.T) {
 		Spec: mcpv1alpha1.MCPProviderSpec{
 			Mode:  "container",
 			Image: "test-image:latest",
-			NodeSelector: map[string]string{
-				"disktype": "ssd",
-				"zone":     "us-west-1a",
+			LivenessProbe: &mcpv1alpha1.Probe{
+				HTTPGet: &mcpv1alpha1.HTTPGetAction{
+					Path: "/healthz",
+					Port: 8080,
+				},
+				InitialDelaySeconds: 5,
+			},
+			ReadinessProbe: &mcpv1alpha1.Probe{
+				TCPSocket: &mcpv1alpha1.TCPSocketAction{
+					Port: 8080,
+				},
+				PeriodSeconds: 5,
+			},
+			StartupProbe: &mcpv1alpha1.Probe{
+				GRPC: &mcpv1alpha1.GRPCAction{
+					Port:    8080,
+					Service: &grpcService,
+				},
+				FailureThreshold: 30,
 			},
 		},
 	}
 
-	pod, err := BuildPodForProvider(provider)
+	pod, err := buildPod(t, provider)
 
 	require.NoError(t, err)
-	assert.Equal(t, "ssd", pod.Spec.NodeSelector["disktype"])
-	assert.Equal(t, "us-west-1a", pod.Spec.NodeSelector["zone"])
+	container := pod.Spec.Containers[0]
+
+	require.NotNil(t, container.LivenessProbe)
+	require.NotNil(t, container.LivenessProbe.HTTPGet)
+	assert.Equal(t, "/healthz", container.LivenessProbe.HTTPGet.Path)
+	assert.Equal(t, int32(8080), container.LivenessProbe.HTTPGet.Port.IntVal)
+	assert.Equal(t, int32(5), container.LivenessProbe.InitialDelaySeconds)
+	assert.Equal(t, int32(10), container.LivenessProbe.PeriodSeconds, "unset PeriodSeconds should default to 10")
+
+	require.NotNil(t, container.ReadinessProbe)
+	require.NotNil(t, container.ReadinessProbe.TCPSocket)
+	assert.Equal(t, int32(8080), container.ReadinessProbe.TCPSocket.Port.IntVal)
+	assert.Equal(t, int32(5), container.ReadinessProbe.PeriodSeconds)
+	assert.Equal(t, int32(3), container.ReadinessProbe.FailureThreshold, "unset FailureThreshold should default to 3")
+
+	require.NotNil(t, container.StartupProbe)
+	require.NotNil(t, container.StartupProbe.GRPC)
+	assert.Equal(t, int32(8080), container.StartupProbe.GRPC.Port)
+	assert.Equal(t, &grpcService, container.StartupProbe.GRPC.Service)
+	assert.Equal(t, int32(30), container.StartupProbe.FailureThreshold)
 }
 
-func TestBuildPodForProvider_WithTolerations(t *testing.T) {
+func TestBuildPodForProvider_WithExecProbe(t *testing.T) {
 	provider := &mcpv1alpha1.MCPProvider{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "test-provider",
@@ -325,71 +805,915 @@ func TestBuildPodForProvider_WithTolerations(t *testing.T) {
 		Spec: mcpv1alpha1.MCPProviderSpec{
 			Mode:  "container",
 			Image: "test-image:latest",
-			Tolerations: []mcpv1alpha1.Toleration{
-				{
-					Key:      "key1",
-					Operator: "Equal",
-					Value:    "value1",
-					Effect:   "NoSchedule",
+			LivenessProbe: &mcpv1alpha1.Probe{
+				Exec: &mcpv1alpha1.ExecAction{
+					Command: []string{"/bin/sh", "-c", "pgrep provider"},
 				},
 			},
 		},
 	}
 
-	pod, err := BuildPodForProvider(provider)
+	pod, err := buildPod(t, provider)
 
 	require.NoError(t, err)
-	require.Len(t, pod.Spec.Tolerations, 1)
-	assert.Equal(t, "key1", pod.Spec.Tolerations[0].Key)
-	assert.Equal(t, corev1.TolerationOperator("Equal"), pod.Spec.Tolerations[0].Operator)
-	assert.Equal(t, "value1", pod.Spec.Tolerations[0].Value)
-	assert.Equal(t, corev1.TaintEffect("NoSchedule"), pod.Spec.Tolerations[0].Effect)
+	probe := pod.Spec.Containers[0].LivenessProbe
+	require.NotNil(t, probe)
+	require.NotNil(t, probe.Exec)
+	assert.Equal(t, []string{"/bin/sh", "-c", "pgrep provider"}, probe.Exec.Command)
 }
 
-func TestBuildPodForProvider_WithServiceAccount(t *testing.T) {
+func TestBuildPodForProvider_WithMCPInitializeProbe_Stdio(t *testing.T) {
 	provider := &mcpv1alpha1.MCPProvider{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "test-provider",
 			Namespace: "default",
 		},
 		Spec: mcpv1alpha1.MCPProviderSpec{
-			Mode:               "container",
-			Image:              "test-image:latest",
-			ServiceAccountName: "custom-sa",
+			Mode:  "container",
+			Image: "test-image:latest",
+			ReadinessProbe: &mcpv1alpha1.Probe{
+				MCPInitialize: &mcpv1alpha1.MCPInitializeAction{},
+			},
 		},
 	}
 
-	pod, err := BuildPodForProvider(provider)
+	pod, err := buildPod(t, provider)
 
 	require.NoError(t, err)
-	assert.Equal(t, "custom-sa", pod.Spec.ServiceAccountName)
+	probe := pod.Spec.Containers[0].ReadinessProbe
+	require.NotNil(t, probe)
+	require.NotNil(t, probe.Exec)
+	assert.Equal(t, []string{"/mcp-probe", "--transport", "stdio"}, probe.Exec.Command)
 }
 
-func TestBuildLabels(t *testing.T) {
+func TestBuildPodForProvider_WithMCPInitializeProbe_HTTP(t *testing.T) {
 	provider := &mcpv1alpha1.MCPProvider{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "test-provider",
 			Namespace: "default",
-			UID:       "test-uid-123",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			ReadinessProbe: &mcpv1alpha1.Probe{
+				MCPInitialize: &mcpv1alpha1.MCPInitializeAction{
+					Transport: "http",
+					Port:      9000,
+					Path:      "/rpc",
+				},
+			},
 		},
 	}
 
-	labels := buildLabels(provider)
+	pod, err := buildPod(t, provider)
 
-	assert.Equal(t, "mcp-hangar-operator", labels[LabelManagedBy])
-	assert.Equal(t, "test-provider", labels[LabelName])
-	assert.Equal(t, "test-provider", labels[LabelInstance])
-	assert.Equal(t, "provider", labels[LabelComponent])
-	assert.Equal(t, "mcp-hangar", labels[LabelPartOf])
-	assert.Equal(t, "test-provider", labels[LabelProvider])
-	assert.Equal(t, "test-uid-123", labels[LabelProviderUID])
+	require.NoError(t, err)
+	probe := pod.Spec.Containers[0].ReadinessProbe
+	require.NotNil(t, probe)
+	require.NotNil(t, probe.Exec)
+	assert.Equal(t, []string{"/mcp-probe", "--transport", "http", "--port", "9000", "--path", "/rpc"}, probe.Exec.Command)
 }
 
-func TestBuildResourceRequirements(t *testing.T) {
-	spec := &mcpv1alpha1.ResourceRequirements{
-		Requests: &mcpv1alpha1.ResourceList{
-			CPU:    "100m",
-			Memory: "128Mi",
+func TestBuildPodForProvider_WithoutProbes(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	container := pod.Spec.Containers[0]
+	assert.Nil(t, container.LivenessProbe)
+	assert.Nil(t, container.ReadinessProbe)
+	assert.Nil(t, container.StartupProbe)
+}
+
+func TestBuildPodForProvider_ProbeRejectsAmbiguousHandler(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			ReadinessProbe: &mcpv1alpha1.Probe{
+				HTTPGet:   &mcpv1alpha1.HTTPGetAction{Path: "/healthz", Port: 8080},
+				TCPSocket: &mcpv1alpha1.TCPSocketAction{Port: 8080},
+			},
+		},
+	}
+
+	_, err := buildPod(t, provider)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "readinessProbe")
+}
+
+func TestBuildPodForProvider_ProbeDefaultsToMCPInitializeWhenNoHandler(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:          "container",
+			Image:         "test-image:latest",
+			LivenessProbe: &mcpv1alpha1.Probe{},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	probe := pod.Spec.Containers[0].LivenessProbe
+	require.NotNil(t, probe)
+	require.NotNil(t, probe.Exec)
+	assert.Equal(t, []string{"/mcp-probe", "--transport", "stdio"}, probe.Exec.Command)
+}
+
+func TestBuildPodForProvider_WithPreStopExecLifecycle(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			Lifecycle: &mcpv1alpha1.Lifecycle{
+				PreStop: &mcpv1alpha1.LifecycleHandler{
+					Exec: &mcpv1alpha1.ExecAction{Command: []string{"/mcp-probe", "shutdown"}},
+				},
+			},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	lifecycle := pod.Spec.Containers[0].Lifecycle
+	require.NotNil(t, lifecycle)
+	require.NotNil(t, lifecycle.PreStop)
+	require.NotNil(t, lifecycle.PreStop.Exec)
+	assert.Equal(t, []string{"/mcp-probe", "shutdown"}, lifecycle.PreStop.Exec.Command)
+}
+
+func TestBuildPodForProvider_WithPostStartAndPreStopLifecycle(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			Lifecycle: &mcpv1alpha1.Lifecycle{
+				PostStart: &mcpv1alpha1.LifecycleHandler{
+					Exec: &mcpv1alpha1.ExecAction{Command: []string{"/mcp-probe", "register"}},
+				},
+				PreStop: &mcpv1alpha1.LifecycleHandler{
+					Exec: &mcpv1alpha1.ExecAction{Command: []string{"/mcp-probe", "shutdown"}},
+				},
+			},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	lifecycle := pod.Spec.Containers[0].Lifecycle
+	require.NotNil(t, lifecycle)
+	require.NotNil(t, lifecycle.PostStart)
+	require.NotNil(t, lifecycle.PostStart.Exec)
+	assert.Equal(t, []string{"/mcp-probe", "register"}, lifecycle.PostStart.Exec.Command)
+	require.NotNil(t, lifecycle.PreStop)
+	require.NotNil(t, lifecycle.PreStop.Exec)
+	assert.Equal(t, []string{"/mcp-probe", "shutdown"}, lifecycle.PreStop.Exec.Command)
+}
+
+func TestBuildPodForProvider_WithPreStopHTTPGetLifecycle(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			Lifecycle: &mcpv1alpha1.Lifecycle{
+				PreStop: &mcpv1alpha1.LifecycleHandler{
+					HTTPGet: &mcpv1alpha1.HTTPGetAction{Path: "/shutdown", Port: 8080},
+				},
+			},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	lifecycle := pod.Spec.Containers[0].Lifecycle
+	require.NotNil(t, lifecycle)
+	require.NotNil(t, lifecycle.PreStop)
+	require.NotNil(t, lifecycle.PreStop.HTTPGet)
+	assert.Equal(t, "/shutdown", lifecycle.PreStop.HTTPGet.Path)
+}
+
+func TestBuildPodForProvider_PreStopRejectsAmbiguousHandler(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			Lifecycle: &mcpv1alpha1.Lifecycle{
+				PreStop: &mcpv1alpha1.LifecycleHandler{
+					Exec:    &mcpv1alpha1.ExecAction{Command: []string{"true"}},
+					HTTPGet: &mcpv1alpha1.HTTPGetAction{Path: "/shutdown", Port: 8080},
+				},
+			},
+		},
+	}
+
+	_, err := buildPod(t, provider)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "lifecycle.preStop")
+}
+
+func TestBuildPodForProvider_WithoutLifecycle(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	assert.Nil(t, pod.Spec.Containers[0].Lifecycle)
+}
+
+func TestGetTerminationGracePeriod_Default(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-provider", Namespace: "default"},
+		Spec:       mcpv1alpha1.MCPProviderSpec{Mode: "container", Image: "test-image:latest"},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	require.NotNil(t, pod.Spec.TerminationGracePeriodSeconds)
+	assert.Equal(t, DefaultTerminationGracePeriodSeconds, *pod.Spec.TerminationGracePeriodSeconds)
+}
+
+func TestGetTerminationGracePeriod_ParsesDuration(t *testing.T) {
+	cases := map[string]int64{
+		"30s":    30,
+		"2m":     120,
+		"1h30m":  5400,
+		"1500ms": 2, // rounds up to whole seconds
+	}
+
+	for shutdownGracePeriod, want := range cases {
+		provider := &mcpv1alpha1.MCPProvider{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-provider", Namespace: "default"},
+			Spec: mcpv1alpha1.MCPProviderSpec{
+				Mode:                "container",
+				Image:               "test-image:latest",
+				ShutdownGracePeriod: shutdownGracePeriod,
+			},
+		}
+
+		pod, err := buildPod(t, provider)
+
+		require.NoError(t, err)
+		require.NotNil(t, pod.Spec.TerminationGracePeriodSeconds)
+		assert.Equal(t, want, *pod.Spec.TerminationGracePeriodSeconds, "shutdownGracePeriod=%q", shutdownGracePeriod)
+	}
+}
+
+func TestGetTerminationGracePeriod_ClampsToMax(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-provider", Namespace: "default"},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:                "container",
+			Image:               "test-image:latest",
+			ShutdownGracePeriod: "24h",
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	require.NotNil(t, pod.Spec.TerminationGracePeriodSeconds)
+	assert.Equal(t, MaxTerminationGracePeriodSeconds, *pod.Spec.TerminationGracePeriodSeconds)
+}
+
+func TestGetTerminationGracePeriod_FallsBackOnUnparseableValue(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-provider", Namespace: "default"},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:                "container",
+			Image:               "test-image:latest",
+			ShutdownGracePeriod: "not-a-duration",
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	require.NotNil(t, pod.Spec.TerminationGracePeriodSeconds)
+	assert.Equal(t, DefaultTerminationGracePeriodSeconds, *pod.Spec.TerminationGracePeriodSeconds)
+}
+
+func TestTerminationGracePeriodSeconds_MatchesPodSpec(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-provider", Namespace: "default"},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:                "container",
+			Image:               "test-image:latest",
+			ShutdownGracePeriod: "45s",
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+	require.NoError(t, err)
+
+	require.NotNil(t, pod.Spec.TerminationGracePeriodSeconds)
+	assert.Equal(t, *pod.Spec.TerminationGracePeriodSeconds, TerminationGracePeriodSeconds(provider))
+}
+
+func TestLifecyclePreStopTimeoutWarning(t *testing.T) {
+	tooShort := &mcpv1alpha1.MCPProvider{
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			ShutdownGracePeriod: "5s",
+			Lifecycle: &mcpv1alpha1.Lifecycle{
+				PreStop: &mcpv1alpha1.LifecycleHandler{
+					Exec:           &mcpv1alpha1.ExecAction{Command: []string{"/mcp-probe", "shutdown"}},
+					TimeoutSeconds: 10,
+				},
+			},
+		},
+	}
+	assert.Contains(t, LifecyclePreStopTimeoutWarning(tooShort), "preStop.timeoutSeconds")
+
+	fine := &mcpv1alpha1.MCPProvider{
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			ShutdownGracePeriod: "30s",
+			Lifecycle: &mcpv1alpha1.Lifecycle{
+				PreStop: &mcpv1alpha1.LifecycleHandler{
+					Exec:           &mcpv1alpha1.ExecAction{Command: []string{"/mcp-probe", "shutdown"}},
+					TimeoutSeconds: 10,
+				},
+			},
+		},
+	}
+	assert.Empty(t, LifecyclePreStopTimeoutWarning(fine))
+
+	noLifecycle := &mcpv1alpha1.MCPProvider{
+		Spec: mcpv1alpha1.MCPProviderSpec{ShutdownGracePeriod: "5s"},
+	}
+	assert.Empty(t, LifecyclePreStopTimeoutWarning(noLifecycle))
+}
+
+func TestBuildPodForProvider_WithCommandAndArgs(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:    "container",
+			Image:   "test-image:latest",
+			Command: []string{"/app/provider"},
+			Args:    []string{"--config", "/config/app.yaml", "--verbose"},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	container := pod.Spec.Containers[0]
+
+	assert.Equal(t, []string{"/app/provider"}, container.Command)
+	assert.Equal(t, []string{"--config", "/config/app.yaml", "--verbose"}, container.Args)
+}
+
+func TestBuildPodForProvider_DefaultImagePullPolicy(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	assert.Equal(t, corev1.PullIfNotPresent, pod.Spec.Containers[0].ImagePullPolicy)
+}
+
+func TestBuildPodForProvider_WithImagePullPolicy(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:            "container",
+			Image:           "test-image:latest",
+			ImagePullPolicy: corev1.PullAlways,
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	assert.Equal(t, corev1.PullAlways, pod.Spec.Containers[0].ImagePullPolicy)
+}
+
+func TestBuildPodForProvider_WithImagePullCredentials(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:             "container",
+			Image:            "test-image:latest",
+			ImagePullSecrets: []corev1.LocalObjectReference{{Name: "regcred"}},
+			ImagePullCredentials: &mcpv1alpha1.ImagePullCredentials{
+				ConfigMapRef: &mcpv1alpha1.ImagePullCredentialsConfigMapRef{Name: "inline-creds"},
+			},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	assert.Equal(t, []corev1.LocalObjectReference{
+		{Name: "regcred"},
+		{Name: "test-provider-pull-credentials"},
+	}, pod.Spec.ImagePullSecrets)
+}
+
+func TestBuildPodForProvider_WithPodTemplateOverride(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			PodTemplate: &corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"example.com/debug": "true"},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: ContainerProvider,
+							Env:  []corev1.EnvVar{{Name: "EXTRA_FLAG", Value: "on"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	assert.Equal(t, "true", pod.Annotations["example.com/debug"])
+
+	var extraFlag *corev1.EnvVar
+	for i := range pod.Spec.Containers[0].Env {
+		if pod.Spec.Containers[0].Env[i].Name == "EXTRA_FLAG" {
+			extraFlag = &pod.Spec.Containers[0].Env[i]
+		}
+	}
+	require.NotNil(t, extraFlag)
+	assert.Equal(t, "on", extraFlag.Value)
+	assert.Equal(t, "test-image:latest", pod.Spec.Containers[0].Image)
+}
+
+func TestBuildPodForProvider_PodTemplateRejectsImageOverride(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			PodTemplate: &corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: ContainerProvider, Image: "other-image:latest"},
+					},
+				},
+			},
+		},
+	}
+
+	_, err := buildPod(t, provider)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "podTemplate may not override")
+}
+
+func TestBuildPodForProvider_WithNodeSelector(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			NodeSelector: map[string]string{
+				"disktype": "ssd",
+				"zone":     "us-west-1a",
+			},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	assert.Equal(t, "ssd", pod.Spec.NodeSelector["disktype"])
+	assert.Equal(t, "us-west-1a", pod.Spec.NodeSelector["zone"])
+}
+
+func TestBuildPodForProvider_WithTolerations(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			Tolerations: []mcpv1alpha1.Toleration{
+				{
+					Key:      "key1",
+					Operator: "Equal",
+					Value:    "value1",
+					Effect:   "NoSchedule",
+				},
+			},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	require.Len(t, pod.Spec.Tolerations, 1)
+	assert.Equal(t, "key1", pod.Spec.Tolerations[0].Key)
+	assert.Equal(t, corev1.TolerationOperator("Equal"), pod.Spec.Tolerations[0].Operator)
+	assert.Equal(t, "value1", pod.Spec.Tolerations[0].Value)
+	assert.Equal(t, corev1.TaintEffect("NoSchedule"), pod.Spec.Tolerations[0].Effect)
+}
+
+func TestBuildPodForProvider_WithServiceAccount(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:               "container",
+			Image:              "test-image:latest",
+			ServiceAccountName: "custom-sa",
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	assert.Equal(t, "custom-sa", pod.Spec.ServiceAccountName)
+}
+
+func TestBuildPodForProvider_WithSidecars(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			Volumes: []mcpv1alpha1.Volume{
+				{
+					Name:      "shared-config",
+					MountPath: "/config",
+					ConfigMap: &mcpv1alpha1.ConfigMapVolumeSource{Name: "provider-config"},
+				},
+			},
+			Sidecars: []mcpv1alpha1.SidecarSpec{
+				{
+					Name:    "stdio-proxy",
+					Image:   "proxy:latest",
+					Command: []string{"proxy"},
+					Args:    []string{"--stdio"},
+					Env:     []mcpv1alpha1.EnvVar{{Name: "PROXY_PORT", Value: "8080"}},
+					Resources: &mcpv1alpha1.ResourceRequirements{
+						Requests: &mcpv1alpha1.ResourceList{CPU: "50m"},
+					},
+					VolumeMounts: []mcpv1alpha1.VolumeMount{
+						{Name: "shared-config", MountPath: "/etc/proxy-config", ReadOnly: true},
+					},
+				},
+			},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	require.Len(t, pod.Spec.Containers, 2)
+
+	sidecar := pod.Spec.Containers[1]
+	assert.Equal(t, "stdio-proxy", sidecar.Name)
+	assert.Equal(t, "proxy:latest", sidecar.Image)
+	assert.Equal(t, []string{"proxy"}, sidecar.Command)
+	assert.Equal(t, []string{"--stdio"}, sidecar.Args)
+	assert.Equal(t, resource.MustParse("50m"), sidecar.Resources.Requests[corev1.ResourceCPU])
+	assert.NotNil(t, findEnvVar(sidecar.Env, "PROXY_PORT"))
+	assert.NotNil(t, findEnvVar(sidecar.Env, "MCP_PROVIDER_NAME"))
+	require.NotNil(t, sidecar.SecurityContext)
+	assert.True(t, *sidecar.SecurityContext.RunAsNonRoot)
+
+	mount := findVolumeMount(sidecar.VolumeMounts, "shared-config")
+	require.NotNil(t, mount)
+	assert.Equal(t, "/etc/proxy-config", mount.MountPath)
+	assert.True(t, mount.ReadOnly)
+
+	// The main container only gets the mount at its own declared path.
+	mainMount := findVolumeMount(pod.Spec.Containers[0].VolumeMounts, "shared-config")
+	require.NotNil(t, mainMount)
+	assert.Equal(t, "/config", mainMount.MountPath)
+}
+
+func TestBuildPodForProvider_WithNativeSidecar(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			InitContainers: []mcpv1alpha1.InitContainerSpec{
+				{Name: "fetch-weights", Image: "fetcher:latest"},
+			},
+			Sidecars: []mcpv1alpha1.SidecarSpec{
+				{
+					Name:          "vector-db",
+					Image:         "vector-db:latest",
+					RestartPolicy: corev1.ContainerRestartPolicyAlways,
+				},
+				{
+					Name:  "stdio-proxy",
+					Image: "proxy:latest",
+				},
+			},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+
+	// The native sidecar runs as an init container with RestartPolicy
+	// Always, ahead of the user-defined InitContainers, so it's already up
+	// for them to depend on.
+	require.Len(t, pod.Spec.InitContainers, 2)
+	nativeSidecar := pod.Spec.InitContainers[0]
+	assert.Equal(t, "vector-db", nativeSidecar.Name)
+	require.NotNil(t, nativeSidecar.RestartPolicy)
+	assert.Equal(t, corev1.ContainerRestartPolicyAlways, *nativeSidecar.RestartPolicy)
+	assert.Equal(t, "fetch-weights", pod.Spec.InitContainers[1].Name)
+
+	// The non-native sidecar stays a regular container.
+	require.Len(t, pod.Spec.Containers, 2)
+	assert.Equal(t, "stdio-proxy", pod.Spec.Containers[1].Name)
+	assert.Nil(t, pod.Spec.Containers[1].RestartPolicy)
+}
+
+func TestBuildPodForProvider_WithHelper(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+			Volumes: []mcpv1alpha1.Volume{
+				{
+					Name:      "creds",
+					MountPath: "/creds",
+					Secret:    &mcpv1alpha1.SecretVolumeSource{SecretName: "oauth-creds"},
+				},
+			},
+			Helper: &mcpv1alpha1.HelperSpec{
+				Image: "token-refresher:latest",
+				Env:   []mcpv1alpha1.EnvVar{{Name: "REFRESH_INTERVAL", Value: "60s"}},
+			},
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+
+	require.NoError(t, err)
+	require.Len(t, pod.Spec.Containers, 2)
+
+	helper := pod.Spec.Containers[1]
+	assert.Equal(t, ContainerHelper, helper.Name)
+	assert.Equal(t, "token-refresher:latest", helper.Image)
+	assert.NotNil(t, findEnvVar(helper.Env, "REFRESH_INTERVAL"))
+	assert.NotNil(t, findEnvVar(helper.Env, "MCP_PROVIDER_NAME"))
+
+	// The helper mirrors the main container's Volume mounts.
+	assert.Equal(t, pod.Spec.Containers[0].VolumeMounts, helper.VolumeMounts)
+}
+
+func TestBuildWorkloadForProvider_WorkloadKinds(t *testing.T) {
+	storageClass := "fast-ssd"
+	replicas := int32(3)
+
+	newProvider := func(kind mcpv1alpha1.WorkloadKind) *mcpv1alpha1.MCPProvider {
+		return &mcpv1alpha1.MCPProvider{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-provider",
+				Namespace: "default",
+				UID:       "test-uid-123",
+			},
+			Spec: mcpv1alpha1.MCPProviderSpec{
+				Mode:         "container",
+				Image:        "test-image:latest",
+				WorkloadKind: kind,
+				Replicas:     &replicas,
+				ServiceName:  "test-provider-headless",
+				Resources: &mcpv1alpha1.ResourceRequirements{
+					Requests: &mcpv1alpha1.ResourceList{CPU: "100m", Memory: "128Mi"},
+				},
+				Env: []mcpv1alpha1.EnvVar{{Name: "CUSTOM_VAR", Value: "custom-value"}},
+				Volumes: []mcpv1alpha1.Volume{
+					{
+						Name:      "config",
+						MountPath: "/config",
+						ConfigMap: &mcpv1alpha1.ConfigMapVolumeSource{Name: "provider-config"},
+					},
+				},
+				SecurityContext: &mcpv1alpha1.SecurityContext{
+					RunAsUser: func() *int64 { v := int64(1000); return &v }(),
+				},
+				VolumeClaimTemplates: []mcpv1alpha1.PersistentVolumeClaimTemplate{
+					{
+						Name:             "data",
+						StorageClassName: &storageClass,
+						AccessModes:      []string{"ReadWriteOnce"},
+						Storage:          "5Gi",
+					},
+				},
+			},
+		}
+	}
+
+	assertPodTemplateFeatures := func(t *testing.T, podSpec corev1.PodSpec) {
+		t.Helper()
+		container := podSpec.Containers[0]
+		assert.Equal(t, resource.MustParse("100m"), container.Resources.Requests[corev1.ResourceCPU])
+		assert.NotNil(t, findEnvVar(container.Env, "CUSTOM_VAR"))
+		assert.NotNil(t, findVolume(podSpec.Volumes, "config"))
+		require.NotNil(t, container.SecurityContext.RunAsUser)
+		assert.Equal(t, int64(1000), *container.SecurityContext.RunAsUser)
+	}
+
+	t.Run("Pod", func(t *testing.T) {
+		obj, err := BuildWorkloadForProvider(newProvider(mcpv1alpha1.WorkloadKindPod))
+		require.NoError(t, err)
+
+		pod, ok := obj.(*corev1.Pod)
+		require.True(t, ok, "expected *corev1.Pod, got %T", obj)
+		assert.Equal(t, corev1.RestartPolicyNever, pod.Spec.RestartPolicy)
+		assertPodTemplateFeatures(t, pod.Spec)
+	})
+
+	t.Run("Deployment", func(t *testing.T) {
+		obj, err := BuildWorkloadForProvider(newProvider(mcpv1alpha1.WorkloadKindDeployment))
+		require.NoError(t, err)
+
+		deployment, ok := obj.(*appsv1.Deployment)
+		require.True(t, ok, "expected *appsv1.Deployment, got %T", obj)
+		require.NotNil(t, deployment.Spec.Replicas)
+		assert.Equal(t, int32(3), *deployment.Spec.Replicas)
+		assert.Equal(t, corev1.RestartPolicyAlways, deployment.Spec.Template.Spec.RestartPolicy)
+		assert.Equal(t, appsv1.RollingUpdateDeploymentStrategyType, deployment.Spec.Strategy.Type)
+		assertPodTemplateFeatures(t, deployment.Spec.Template.Spec)
+	})
+
+	t.Run("StatefulSet", func(t *testing.T) {
+		obj, err := BuildWorkloadForProvider(newProvider(mcpv1alpha1.WorkloadKindStatefulSet))
+		require.NoError(t, err)
+
+		sts, ok := obj.(*appsv1.StatefulSet)
+		require.True(t, ok, "expected *appsv1.StatefulSet, got %T", obj)
+		require.NotNil(t, sts.Spec.Replicas)
+		assert.Equal(t, int32(3), *sts.Spec.Replicas)
+		assert.Equal(t, "test-provider-headless", sts.Spec.ServiceName)
+		assert.Equal(t, corev1.RestartPolicyAlways, sts.Spec.Template.Spec.RestartPolicy)
+		assert.Equal(t, appsv1.RollingUpdateStatefulSetStrategyType, sts.Spec.UpdateStrategy.Type)
+		assertPodTemplateFeatures(t, sts.Spec.Template.Spec)
+
+		require.Len(t, sts.Spec.VolumeClaimTemplates, 1)
+		assert.Equal(t, "data", sts.Spec.VolumeClaimTemplates[0].Name)
+		assert.Equal(t, "5Gi", sts.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests.Storage().String())
+	})
+}
+
+func TestBuildWorkloadForProvider_RecreateStrategy(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-provider", Namespace: "default"},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:           "container",
+			Image:          "test-image:latest",
+			WorkloadKind:   mcpv1alpha1.WorkloadKindDeployment,
+			UpdateStrategy: &mcpv1alpha1.UpdateStrategy{Type: mcpv1alpha1.UpdateStrategyRecreate},
+		},
+	}
+
+	obj, err := BuildWorkloadForProvider(provider)
+
+	require.NoError(t, err)
+	deployment, ok := obj.(*appsv1.Deployment)
+	require.True(t, ok, "expected *appsv1.Deployment, got %T", obj)
+	assert.Equal(t, appsv1.RecreateDeploymentStrategyType, deployment.Spec.Strategy.Type)
+}
+
+func TestBuildWorkloadForProvider_UnknownKind(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-provider", Namespace: "default"},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:         "container",
+			Image:        "test-image:latest",
+			WorkloadKind: "made-up",
+		},
+	}
+
+	_, err := BuildWorkloadForProvider(provider)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "made-up")
+}
+
+func TestBuildLabels(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+			UID:       "test-uid-123",
+		},
+	}
+
+	labels := buildLabels(provider)
+
+	assert.Equal(t, "mcp-hangar-operator", labels[LabelManagedBy])
+	assert.Equal(t, "test-provider", labels[LabelName])
+	assert.Equal(t, "test-provider", labels[LabelInstance])
+	assert.Equal(t, "provider", labels[LabelComponent])
+	assert.Equal(t, "mcp-hangar", labels[LabelPartOf])
+	assert.Equal(t, "test-provider", labels[LabelProvider])
+	assert.Equal(t, "test-uid-123", labels[LabelProviderUID])
+}
+
+func TestBuildResourceRequirements(t *testing.T) {
+	spec := &mcpv1alpha1.ResourceRequirements{
+		Requests: &mcpv1alpha1.ResourceList{
+			CPU:    "100m",
+			Memory: "128Mi",
 		},
 		Limits: &mcpv1alpha1.ResourceList{
 			CPU:    "1",
@@ -420,6 +1744,76 @@ func TestBuildResourceRequirements_Partial(t *testing.T) {
 	assert.Empty(t, reqs.Limits)
 }
 
+func TestPodOrdinalName(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-provider"},
+	}
+
+	assert.Equal(t, "mcp-provider-test-provider", PodOrdinalName(provider, 0))
+	assert.Equal(t, "mcp-provider-test-provider-1", PodOrdinalName(provider, 1))
+	assert.Equal(t, "mcp-provider-test-provider-2", PodOrdinalName(provider, 2))
+}
+
+func TestBuildPodsForProvider(t *testing.T) {
+	replicas := int32(3)
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-provider",
+			Namespace: "default",
+		},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:     "container",
+			Image:    "test-image:latest",
+			Replicas: &replicas,
+		},
+	}
+
+	pods, err := BuildPodsForProvider(provider)
+	require.NoError(t, err)
+	require.Len(t, pods, 3)
+
+	wantHash := PodTemplateHash(pods[0])
+	for i, pod := range pods {
+		assert.Equal(t, PodOrdinalName(provider, int32(i)), pod.Name)
+		assert.Equal(t, strconv.Itoa(i), pod.Labels[LabelPodOrdinal])
+		assert.Equal(t, wantHash, pod.Labels[LabelTemplateHash])
+	}
+}
+
+func TestBuildPodsForProvider_NotPodWorkload(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-provider", Namespace: "default"},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:         "container",
+			Image:        "test-image:latest",
+			WorkloadKind: mcpv1alpha1.WorkloadKindDeployment,
+		},
+	}
+
+	_, err := BuildPodsForProvider(provider)
+	assert.Error(t, err)
+}
+
+func TestPodTemplateHash_ChangesWithSpec(t *testing.T) {
+	provider := &mcpv1alpha1.MCPProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-provider", Namespace: "default"},
+		Spec: mcpv1alpha1.MCPProviderSpec{
+			Mode:  "container",
+			Image: "test-image:latest",
+		},
+	}
+
+	pod, err := buildPod(t, provider)
+	require.NoError(t, err)
+	hash := PodTemplateHash(pod)
+	assert.NotEmpty(t, hash)
+
+	provider.Spec.Image = "test-image:v2"
+	pod2, err := buildPod(t, provider)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, PodTemplateHash(pod2))
+}
+
 // Helper functions
 
 func findEnvVar(envVars []corev1.EnvVar, name string) *corev1.EnvVar {
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"time"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+const (
+	// DefaultMaxFailures is how many consecutive Pod failures the
+	// reconciler tolerates before opening the circuit.
+	DefaultMaxFailures int32 = 5
+
+	// DefaultInitialCooldown is how long the circuit stays open before the
+	// first half-open retry.
+	DefaultInitialCooldown = 10 * time.Second
+
+	// DefaultMaxCooldown caps how long RestartCooldown can grow to.
+	DefaultMaxCooldown = 5 * time.Minute
+
+	// DefaultBackoffMultiplier scales the cooldown on each half-open failure.
+	DefaultBackoffMultiplier = 2.0
+)
+
+// RestartPolicyMaxFailures returns policy.MaxFailures, falling back to
+// DefaultMaxFailures when policy is nil or the field is unset.
+func RestartPolicyMaxFailures(policy *mcpv1alpha1.RestartPolicy) int32 {
+	if policy == nil || policy.MaxFailures <= 0 {
+		return DefaultMaxFailures
+	}
+	return policy.MaxFailures
+}
+
+// RestartCooldown returns how long the reconciler should wait before
+// giving a failing provider a half-open retry, after the circuit has
+// opened `reopens` times (0 the first time it opens). The cooldown starts
+// at policy.InitialCooldown and scales by policy.BackoffMultiplier on each
+// reopen, capped at policy.MaxCooldown. Unset or unparseable policy fields
+// fall back to the Default* constants above.
+func RestartCooldown(policy *mcpv1alpha1.RestartPolicy, reopens int32) time.Duration {
+	initial := DefaultInitialCooldown
+	maxCooldown := DefaultMaxCooldown
+	multiplier := DefaultBackoffMultiplier
+
+	if policy != nil {
+		if d, err := time.ParseDuration(policy.InitialCooldown); err == nil && d > 0 {
+			initial = d
+		}
+		if d, err := time.ParseDuration(policy.MaxCooldown); err == nil && d > 0 {
+			maxCooldown = d
+		}
+		if policy.BackoffMultiplier > 1 {
+			multiplier = policy.BackoffMultiplier
+		}
+	}
+
+	cooldown := float64(initial)
+	for i := int32(0); i < reopens; i++ {
+		cooldown *= multiplier
+		if cooldown >= float64(maxCooldown) {
+			return maxCooldown
+		}
+	}
+	return time.Duration(cooldown)
+}
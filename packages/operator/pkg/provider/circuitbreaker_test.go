@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+func TestRestartPolicyMaxFailures_DefaultsWhenUnset(t *testing.T) {
+	assert.Equal(t, DefaultMaxFailures, RestartPolicyMaxFailures(nil))
+	assert.Equal(t, DefaultMaxFailures, RestartPolicyMaxFailures(&mcpv1alpha1.RestartPolicy{}))
+	assert.Equal(t, int32(3), RestartPolicyMaxFailures(&mcpv1alpha1.RestartPolicy{MaxFailures: 3}))
+}
+
+func TestRestartCooldown_DefaultsWhenUnset(t *testing.T) {
+	assert.Equal(t, DefaultInitialCooldown, RestartCooldown(nil, 0))
+	assert.Equal(t, DefaultInitialCooldown*DefaultBackoffMultiplier, RestartCooldown(nil, 1))
+}
+
+func TestRestartCooldown_GrowsExponentiallyAndClamps(t *testing.T) {
+	policy := &mcpv1alpha1.RestartPolicy{
+		InitialCooldown:   "1s",
+		MaxCooldown:       "10s",
+		BackoffMultiplier: 2,
+	}
+
+	assert.Equal(t, 1*time.Second, RestartCooldown(policy, 0))
+	assert.Equal(t, 2*time.Second, RestartCooldown(policy, 1))
+	assert.Equal(t, 4*time.Second, RestartCooldown(policy, 2))
+	assert.Equal(t, 10*time.Second, RestartCooldown(policy, 10))
+}
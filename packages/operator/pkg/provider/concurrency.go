@@ -0,0 +1,177 @@
+// concurrency.go implements AdaptiveConcurrencyConfig's Gradient2 limiter:
+// an additive-increase/multiplicative-decrease limit that tracks RTT
+// degradation instead of counting errors. There is no group router in this
+// tree yet to call TryAcquire/Release on it or feed its typed error into the
+// circuit breaker's retryOn, so this is a self-contained limiter a future
+// router can wrap outbound calls with, and Limit/InFlight/RttP50 below and
+// the metrics.ProviderRTT/ProviderConcurrencyLimit/ProviderConcurrencyInFlight
+// metrics are unwired until it exists.
+
+package provider
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+const (
+	// DefaultMinLimit applies when AdaptiveConcurrencyConfig.MinLimit is
+	// unset or non-positive.
+	DefaultMinLimit = int32(1)
+	// DefaultMaxLimit applies when AdaptiveConcurrencyConfig.MaxLimit is
+	// unset or non-positive.
+	DefaultMaxLimit = int32(256)
+	// DefaultQueueSize applies when AdaptiveConcurrencyConfig.QueueSize is
+	// unset.
+	DefaultQueueSize = int32(4)
+
+	// sampleAlpha smooths RttSample, the short-window current-RTT EWMA.
+	sampleAlpha = 0.2
+	// noLoadDecay smooths RttNoLoad's slow rise back up after a period of
+	// low latency stops refreshing it with a new minimum - fast to drop to
+	// a new minimum, slow to forget one.
+	noLoadDecay = 0.02
+)
+
+// AdaptiveConcurrencyLimiter implements a Netflix-style Gradient2 limiter
+// for one provider (or one group member): it tracks RttNoLoad (an EWMA of
+// the lowest observed RTT) and RttSample (a short-window EWMA of current
+// RTT), computes gradient = clamp(RttNoLoad/RttSample, 0.5, 1.0), and moves
+// the limit toward currentLimit*gradient + QueueSize every time a call
+// finishes.
+//
+// There's no request-routing path in this tree yet to call TryAcquire/
+// Release from - MCPProviderGroup has no reconciler at all, the same gap
+// group.Tracker's doc comment notes - so this is a self-contained,
+// concurrency-safe piece of logic a future group router or sidecar proxy
+// can drive per provider.
+type AdaptiveConcurrencyLimiter struct {
+	mu sync.Mutex
+
+	minLimit  float64
+	maxLimit  float64
+	queueSize float64
+
+	limit     float64
+	rttNoLoad time.Duration
+	rttSample time.Duration
+	inFlight  int32
+}
+
+// NewAdaptiveConcurrencyLimiter builds a limiter starting at config's
+// MinLimit, the conservative starting point Gradient2 implementations use
+// until real RTT samples are available to justify growing it.
+func NewAdaptiveConcurrencyLimiter(config *mcpv1alpha1.AdaptiveConcurrencyConfig) *AdaptiveConcurrencyLimiter {
+	min := float64(ResolveMinLimit(config))
+	return &AdaptiveConcurrencyLimiter{
+		minLimit:  min,
+		maxLimit:  float64(ResolveMaxLimit(config)),
+		queueSize: float64(ResolveQueueSize(config)),
+		limit:     min,
+	}
+}
+
+// Limit returns the current concurrency limit.
+func (l *AdaptiveConcurrencyLimiter) Limit() int32 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int32(l.limit)
+}
+
+// InFlight returns the current in-flight call count.
+func (l *AdaptiveConcurrencyLimiter) InFlight() int32 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inFlight
+}
+
+// RttP50 returns the limiter's short-window RTT EWMA - an
+// exponentially-weighted approximation of the median, cheap enough to
+// update on every sample unlike a true streaming percentile sketch.
+func (l *AdaptiveConcurrencyLimiter) RttP50() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.rttSample
+}
+
+// TryAcquire reports whether a new call should be let through. false means
+// InFlight has reached Limit; callers should short-circuit with a typed
+// error that feeds the circuit breaker's retryOn rather than queuing
+// indefinitely. Callers that get true must call Release when the call
+// finishes.
+func (l *AdaptiveConcurrencyLimiter) TryAcquire() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if float64(l.inFlight) >= l.limit {
+		return false
+	}
+	l.inFlight++
+	return true
+}
+
+// Release records one call's observed RTT, updates the gradient limiter's
+// EWMAs, and recomputes the limit.
+func (l *AdaptiveConcurrencyLimiter) Release(rtt time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.inFlight > 0 {
+		l.inFlight--
+	}
+
+	switch {
+	case l.rttNoLoad == 0 || rtt < l.rttNoLoad:
+		l.rttNoLoad = rtt
+	default:
+		l.rttNoLoad = ewma(l.rttNoLoad, rtt, noLoadDecay)
+	}
+
+	if l.rttSample == 0 {
+		l.rttSample = rtt
+	} else {
+		l.rttSample = ewma(l.rttSample, rtt, sampleAlpha)
+	}
+
+	gradient := 1.0
+	if l.rttSample > 0 {
+		gradient = float64(l.rttNoLoad) / float64(l.rttSample)
+	}
+	gradient = math.Max(0.5, math.Min(1.0, gradient))
+
+	newLimit := l.limit*gradient + l.queueSize
+	l.limit = math.Max(l.minLimit, math.Min(l.maxLimit, newLimit))
+}
+
+func ewma(prev, sample time.Duration, alpha float64) time.Duration {
+	return time.Duration(float64(prev)*(1-alpha) + float64(sample)*alpha)
+}
+
+// ResolveMinLimit returns config.MinLimit, falling back to DefaultMinLimit
+// when config is nil or the field is unset.
+func ResolveMinLimit(config *mcpv1alpha1.AdaptiveConcurrencyConfig) int32 {
+	if config == nil || config.MinLimit <= 0 {
+		return DefaultMinLimit
+	}
+	return config.MinLimit
+}
+
+// ResolveMaxLimit returns config.MaxLimit, falling back to DefaultMaxLimit
+// when config is nil or the field is unset.
+func ResolveMaxLimit(config *mcpv1alpha1.AdaptiveConcurrencyConfig) int32 {
+	if config == nil || config.MaxLimit <= 0 {
+		return DefaultMaxLimit
+	}
+	return config.MaxLimit
+}
+
+// ResolveQueueSize returns config.QueueSize, falling back to
+// DefaultQueueSize when config is nil or the field is unset.
+func ResolveQueueSize(config *mcpv1alpha1.AdaptiveConcurrencyConfig) int32 {
+	if config == nil || config.QueueSize <= 0 {
+		return DefaultQueueSize
+	}
+	return config.QueueSize
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+func TestResolveMinLimit_DefaultsWhenUnset(t *testing.T) {
+	assert.Equal(t, DefaultMinLimit, ResolveMinLimit(nil))
+	assert.Equal(t, DefaultMinLimit, ResolveMinLimit(&mcpv1alpha1.AdaptiveConcurrencyConfig{}))
+	assert.Equal(t, int32(5), ResolveMinLimit(&mcpv1alpha1.AdaptiveConcurrencyConfig{MinLimit: 5}))
+}
+
+func TestResolveMaxLimit_DefaultsWhenUnset(t *testing.T) {
+	assert.Equal(t, DefaultMaxLimit, ResolveMaxLimit(nil))
+	assert.Equal(t, int32(10), ResolveMaxLimit(&mcpv1alpha1.AdaptiveConcurrencyConfig{MaxLimit: 10}))
+}
+
+func TestResolveQueueSize_DefaultsWhenUnset(t *testing.T) {
+	assert.Equal(t, DefaultQueueSize, ResolveQueueSize(nil))
+	assert.Equal(t, int32(1), ResolveQueueSize(&mcpv1alpha1.AdaptiveConcurrencyConfig{QueueSize: 1}))
+}
+
+func TestAdaptiveConcurrencyLimiter_StartsAtMinLimit(t *testing.T) {
+	l := NewAdaptiveConcurrencyLimiter(&mcpv1alpha1.AdaptiveConcurrencyConfig{MinLimit: 3, MaxLimit: 100})
+	assert.Equal(t, int32(3), l.Limit())
+}
+
+func TestAdaptiveConcurrencyLimiter_TryAcquireBlocksAtLimit(t *testing.T) {
+	l := NewAdaptiveConcurrencyLimiter(&mcpv1alpha1.AdaptiveConcurrencyConfig{MinLimit: 1, MaxLimit: 100})
+
+	assert.True(t, l.TryAcquire())
+	assert.Equal(t, int32(1), l.InFlight())
+	assert.False(t, l.TryAcquire(), "limit is 1 and one call is already in flight")
+}
+
+func TestAdaptiveConcurrencyLimiter_GrowsLimitOnLowLatency(t *testing.T) {
+	l := NewAdaptiveConcurrencyLimiter(&mcpv1alpha1.AdaptiveConcurrencyConfig{MinLimit: 1, MaxLimit: 100, QueueSize: 4})
+
+	for i := 0; i < 20; i++ {
+		l.TryAcquire()
+		l.Release(10 * time.Millisecond)
+	}
+
+	assert.Greater(t, l.Limit(), int32(1), "stable low latency should grow the limit via QueueSize headroom")
+	assert.LessOrEqual(t, l.Limit(), int32(100))
+}
+
+func TestAdaptiveConcurrencyLimiter_ShrinksLimitOnLatencySpike(t *testing.T) {
+	l := NewAdaptiveConcurrencyLimiter(&mcpv1alpha1.AdaptiveConcurrencyConfig{MinLimit: 1, MaxLimit: 100, QueueSize: 4})
+
+	for i := 0; i < 20; i++ {
+		l.TryAcquire()
+		l.Release(10 * time.Millisecond)
+	}
+	grownLimit := l.Limit()
+
+	for i := 0; i < 5; i++ {
+		l.TryAcquire()
+		l.Release(200 * time.Millisecond)
+	}
+
+	assert.Less(t, l.Limit(), grownLimit, "a latency spike should shrink the limit back down")
+}
+
+func TestAdaptiveConcurrencyLimiter_RttP50TracksRecentSamples(t *testing.T) {
+	l := NewAdaptiveConcurrencyLimiter(&mcpv1alpha1.AdaptiveConcurrencyConfig{MinLimit: 1, MaxLimit: 100})
+
+	l.TryAcquire()
+	l.Release(50 * time.Millisecond)
+
+	assert.Equal(t, 50*time.Millisecond, l.RttP50())
+}
+
+func TestAdaptiveConcurrencyLimiter_LimitNeverBelowMin(t *testing.T) {
+	l := NewAdaptiveConcurrencyLimiter(&mcpv1alpha1.AdaptiveConcurrencyConfig{MinLimit: 2, MaxLimit: 100, QueueSize: 0})
+
+	for i := 0; i < 10; i++ {
+		l.TryAcquire()
+		l.Release(time.Second)
+	}
+
+	assert.GreaterOrEqual(t, l.Limit(), int32(2))
+}
@@ -0,0 +1,19 @@
+package provider
+
+import (
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+// DefaultPodManagementPolicy applies when PersistentState or its
+// PodManagementPolicy field is unset, matching StatefulSet's own default.
+const DefaultPodManagementPolicy = mcpv1alpha1.PodManagementPolicyOrderedReady
+
+// ResolvePodManagementPolicy returns persistentState's PodManagementPolicy,
+// defaulting to DefaultPodManagementPolicy when persistentState is nil or
+// the field is unset.
+func ResolvePodManagementPolicy(persistentState *mcpv1alpha1.PersistentStateConfig) mcpv1alpha1.PodManagementPolicyType {
+	if persistentState == nil || persistentState.PodManagementPolicy == "" {
+		return DefaultPodManagementPolicy
+	}
+	return persistentState.PodManagementPolicy
+}
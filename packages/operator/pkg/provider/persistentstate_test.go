@@ -0,0 +1,17 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+func TestResolvePodManagementPolicy_DefaultsWhenUnset(t *testing.T) {
+	assert.Equal(t, mcpv1alpha1.PodManagementPolicyOrderedReady, ResolvePodManagementPolicy(nil))
+	assert.Equal(t, mcpv1alpha1.PodManagementPolicyOrderedReady, ResolvePodManagementPolicy(&mcpv1alpha1.PersistentStateConfig{}))
+	assert.Equal(t, mcpv1alpha1.PodManagementPolicyParallel, ResolvePodManagementPolicy(&mcpv1alpha1.PersistentStateConfig{
+		PodManagementPolicy: mcpv1alpha1.PodManagementPolicyParallel,
+	}))
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"math/rand"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// MinPullBackoff is the delay before the first retry of a Pod stuck in
+	// ImagePullBackOff/ErrImagePull.
+	MinPullBackoff = 2 * time.Second
+
+	// MaxPullBackoff caps how long the reconciler waits between checks of
+	// a Pod that keeps failing to pull its image.
+	MaxPullBackoff = 5 * time.Minute
+)
+
+// imagePullFailureReasons are the waiting-container reasons the kubelet
+// reports while it retries a failing image pull.
+var imagePullFailureReasons = map[string]bool{
+	"ImagePullBackOff": true,
+	"ErrImagePull":     true,
+}
+
+// ImagePullFailureReason inspects a Pod's container statuses for a
+// container stuck in ImagePullBackOff or ErrImagePull. It returns an empty
+// reason if no such container is found, e.g. because the pod is still
+// being scheduled or the image pulled successfully.
+func ImagePullFailureReason(pod *corev1.Pod) (reason, message string) {
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.State.Waiting != nil && imagePullFailureReasons[cs.State.Waiting.Reason] {
+			return cs.State.Waiting.Reason, cs.State.Waiting.Message
+		}
+	}
+	return "", ""
+}
+
+// PullBackoffDelay returns the delay the reconciler should wait before
+// re-checking a Pod stuck in ImagePullBackOff/ErrImagePull. The delay
+// doubles from MinPullBackoff up to MaxPullBackoff with attempts, plus up
+// to 20% jitter so many providers failing against the same registry don't
+// all retry in lockstep.
+func PullBackoffDelay(attempts int32) time.Duration {
+	delay := MinPullBackoff
+	for i := int32(0); i < attempts && delay < MaxPullBackoff; i++ {
+		delay *= 2
+	}
+	if delay > MaxPullBackoff {
+		delay = MaxPullBackoff
+	}
+
+	jitter := time.Duration(rand.Int63n(int64(delay) / 5))
+	return delay + jitter
+}
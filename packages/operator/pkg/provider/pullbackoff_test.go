@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestImagePullFailureReason_DetectsBackOff(t *testing.T) {
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: ContainerProvider,
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{
+							Reason:  "ImagePullBackOff",
+							Message: "Back-off pulling image \"test-image:latest\"",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	reason, message := ImagePullFailureReason(pod)
+
+	assert.Equal(t, "ImagePullBackOff", reason)
+	assert.Equal(t, "Back-off pulling image \"test-image:latest\"", message)
+}
+
+func TestImagePullFailureReason_IgnoresOtherWaitingReasons(t *testing.T) {
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:  ContainerProvider,
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}},
+				},
+			},
+		},
+	}
+
+	reason, message := ImagePullFailureReason(pod)
+
+	assert.Empty(t, reason)
+	assert.Empty(t, message)
+}
+
+func TestPullBackoffDelay_GrowsExponentiallyAndClamps(t *testing.T) {
+	assert.GreaterOrEqual(t, PullBackoffDelay(0), MinPullBackoff)
+	assert.Less(t, PullBackoffDelay(0), MinPullBackoff+MinPullBackoff/5+time.Millisecond)
+
+	assert.GreaterOrEqual(t, PullBackoffDelay(20), MaxPullBackoff)
+	assert.Less(t, PullBackoffDelay(20), MaxPullBackoff+MaxPullBackoff/5+time.Millisecond)
+}
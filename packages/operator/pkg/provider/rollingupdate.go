@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+// DefaultMaxSurge and DefaultMaxUnavailable apply when
+// MCPProviderSpec.RollingUpdate or one of its fields is unset, matching the
+// Deployment controller's own RollingUpdateDeployment defaults.
+const (
+	DefaultMaxSurge       = "25%"
+	DefaultMaxUnavailable = "25%"
+)
+
+// ResolveMaxSurge returns how many Pods above replicas a rolling update may
+// create at once, resolving config's percentage-or-absolute value the same
+// way the Deployment controller resolves RollingUpdateDeployment.MaxSurge.
+// A nil config or field falls back to DefaultMaxSurge.
+func ResolveMaxSurge(config *mcpv1alpha1.RollingUpdateConfig, replicas int32) int32 {
+	value := mustParse(DefaultMaxSurge)
+	if config != nil && config.MaxSurge != nil {
+		value = *config.MaxSurge
+	}
+	surge, _ := intstr.GetScaledValueFromIntOrPercent(&value, int(replicas), true)
+	return int32(surge)
+}
+
+// ResolveMaxUnavailable returns how many Pods below replicas a rolling
+// update may take down at once, resolving config's percentage-or-absolute
+// value the same way the Deployment controller resolves
+// RollingUpdateDeployment.MaxUnavailable. A nil config or field falls back
+// to DefaultMaxUnavailable.
+func ResolveMaxUnavailable(config *mcpv1alpha1.RollingUpdateConfig, replicas int32) int32 {
+	value := mustParse(DefaultMaxUnavailable)
+	if config != nil && config.MaxUnavailable != nil {
+		value = *config.MaxUnavailable
+	}
+	unavailable, _ := intstr.GetScaledValueFromIntOrPercent(&value, int(replicas), false)
+	return int32(unavailable)
+}
+
+// mustParse builds an IntOrString from one of our own default percentage
+// literals above, which are always valid.
+func mustParse(s string) intstr.IntOrString {
+	return intstr.FromString(s)
+}
+
+// DefaultCanaryWeight, DefaultAnalysisInterval, and DefaultPromoteAfter
+// apply when a RolloutStrategy's Canary or BlueGreen parameters, or one of
+// their fields, are unset.
+const (
+	DefaultCanaryWeight     = 10
+	DefaultAnalysisInterval = 5 * time.Minute
+	DefaultPromoteAfter     = 5 * time.Minute
+)
+
+// ResolveRolloutStrategyType returns strategy's Type, defaulting to
+// RollingUpdate when strategy is unset or Type is empty, so a nil
+// RolloutStrategy rolls out exactly like before RolloutStrategy existed.
+func ResolveRolloutStrategyType(strategy *mcpv1alpha1.RolloutStrategy) mcpv1alpha1.RolloutStrategyType {
+	if strategy == nil || strategy.Type == "" {
+		return mcpv1alpha1.RolloutStrategyRollingUpdate
+	}
+	return strategy.Type
+}
+
+// ResolveRollingUpdateConfig returns the RollingUpdateConfig a
+// RolloutStrategyRollingUpdate rollout should use: strategy's own
+// RollingUpdate field if set, else the sibling Spec.RollingUpdate field, so
+// specs written before RolloutStrategy existed keep working unchanged.
+func ResolveRollingUpdateConfig(strategy *mcpv1alpha1.RolloutStrategy, specRollingUpdate *mcpv1alpha1.RollingUpdateConfig) *mcpv1alpha1.RollingUpdateConfig {
+	if strategy != nil && strategy.RollingUpdate != nil {
+		return strategy.RollingUpdate
+	}
+	return specRollingUpdate
+}
+
+// ResolveCanaryWeight returns canary's Weight, defaulting to
+// DefaultCanaryWeight when canary is unset or Weight is zero.
+func ResolveCanaryWeight(canary *mcpv1alpha1.CanaryStrategy) int32 {
+	if canary == nil || canary.Weight == 0 {
+		return DefaultCanaryWeight
+	}
+	return canary.Weight
+}
+
+// ResolveAnalysisInterval parses canary's AnalysisInterval, defaulting to
+// DefaultAnalysisInterval when canary is unset, AnalysisInterval is empty,
+// or it fails to parse.
+func ResolveAnalysisInterval(canary *mcpv1alpha1.CanaryStrategy) time.Duration {
+	if canary == nil || canary.AnalysisInterval == "" {
+		return DefaultAnalysisInterval
+	}
+	if d, err := time.ParseDuration(canary.AnalysisInterval); err == nil {
+		return d
+	}
+	return DefaultAnalysisInterval
+}
+
+// ResolvePromoteAfter parses blueGreen's PromoteAfter, defaulting to
+// DefaultPromoteAfter when blueGreen is unset, PromoteAfter is empty, or it
+// fails to parse.
+func ResolvePromoteAfter(blueGreen *mcpv1alpha1.BlueGreenStrategy) time.Duration {
+	if blueGreen == nil || blueGreen.PromoteAfter == "" {
+		return DefaultPromoteAfter
+	}
+	if d, err := time.ParseDuration(blueGreen.PromoteAfter); err == nil {
+		return d
+	}
+	return DefaultPromoteAfter
+}
+
+// CanaryTargetReplicas returns how many of replicas should run the new Pod
+// template hash under a Canary rollout at the given weight percentage,
+// rounding up so a non-zero weight always surfaces at least one Pod.
+func CanaryTargetReplicas(replicas, weight int32) int32 {
+	if weight <= 0 {
+		return 0
+	}
+	if weight >= 100 {
+		return replicas
+	}
+	return (replicas*weight + 99) / 100
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	mcpv1alpha1 "github.com/mapyr/mcp-hangar/operator/api/v1alpha1"
+)
+
+func TestResolveMaxSurge_DefaultsWhenUnset(t *testing.T) {
+	assert.Equal(t, int32(1), ResolveMaxSurge(nil, 4))
+	assert.Equal(t, int32(1), ResolveMaxSurge(&mcpv1alpha1.RollingUpdateConfig{}, 4))
+}
+
+func TestResolveMaxSurge_AbsoluteAndPercent(t *testing.T) {
+	absolute := intstr.FromInt(2)
+	config := &mcpv1alpha1.RollingUpdateConfig{MaxSurge: &absolute}
+	assert.Equal(t, int32(2), ResolveMaxSurge(config, 4))
+
+	percent := intstr.FromString("50%")
+	config = &mcpv1alpha1.RollingUpdateConfig{MaxSurge: &percent}
+	assert.Equal(t, int32(2), ResolveMaxSurge(config, 4))
+}
+
+func TestResolveMaxUnavailable_DefaultsWhenUnset(t *testing.T) {
+	assert.Equal(t, int32(1), ResolveMaxUnavailable(nil, 4))
+	assert.Equal(t, int32(1), ResolveMaxUnavailable(&mcpv1alpha1.RollingUpdateConfig{}, 4))
+}
+
+func TestResolveMaxUnavailable_AbsoluteAndPercent(t *testing.T) {
+	absolute := intstr.FromInt(1)
+	config := &mcpv1alpha1.RollingUpdateConfig{MaxUnavailable: &absolute}
+	assert.Equal(t, int32(1), ResolveMaxUnavailable(config, 4))
+
+	percent := intstr.FromString("50%")
+	config = &mcpv1alpha1.RollingUpdateConfig{MaxUnavailable: &percent}
+	assert.Equal(t, int32(2), ResolveMaxUnavailable(config, 4))
+}
+
+func TestResolveRolloutStrategyType_DefaultsToRollingUpdate(t *testing.T) {
+	assert.Equal(t, mcpv1alpha1.RolloutStrategyRollingUpdate, ResolveRolloutStrategyType(nil))
+	assert.Equal(t, mcpv1alpha1.RolloutStrategyRollingUpdate, ResolveRolloutStrategyType(&mcpv1alpha1.RolloutStrategy{}))
+	assert.Equal(t, mcpv1alpha1.RolloutStrategyCanary, ResolveRolloutStrategyType(&mcpv1alpha1.RolloutStrategy{Type: mcpv1alpha1.RolloutStrategyCanary}))
+}
+
+func TestResolveRollingUpdateConfig_PrefersStrategyOverSpec(t *testing.T) {
+	specConfig := &mcpv1alpha1.RollingUpdateConfig{MaxSurge: ptrIntOrString(intstr.FromInt(1))}
+	assert.Equal(t, specConfig, ResolveRollingUpdateConfig(nil, specConfig))
+
+	strategyConfig := &mcpv1alpha1.RollingUpdateConfig{MaxSurge: ptrIntOrString(intstr.FromInt(2))}
+	strategy := &mcpv1alpha1.RolloutStrategy{RollingUpdate: strategyConfig}
+	assert.Equal(t, strategyConfig, ResolveRollingUpdateConfig(strategy, specConfig))
+}
+
+func TestResolveCanaryWeight_DefaultsWhenUnset(t *testing.T) {
+	assert.Equal(t, int32(10), ResolveCanaryWeight(nil))
+	assert.Equal(t, int32(10), ResolveCanaryWeight(&mcpv1alpha1.CanaryStrategy{}))
+	assert.Equal(t, int32(25), ResolveCanaryWeight(&mcpv1alpha1.CanaryStrategy{Weight: 25}))
+}
+
+func TestResolveAnalysisInterval_DefaultsWhenUnsetOrInvalid(t *testing.T) {
+	assert.Equal(t, 5*time.Minute, ResolveAnalysisInterval(nil))
+	assert.Equal(t, 5*time.Minute, ResolveAnalysisInterval(&mcpv1alpha1.CanaryStrategy{AnalysisInterval: "not-a-duration"}))
+	assert.Equal(t, 2*time.Minute, ResolveAnalysisInterval(&mcpv1alpha1.CanaryStrategy{AnalysisInterval: "2m"}))
+}
+
+func TestResolvePromoteAfter_DefaultsWhenUnsetOrInvalid(t *testing.T) {
+	assert.Equal(t, 5*time.Minute, ResolvePromoteAfter(nil))
+	assert.Equal(t, 5*time.Minute, ResolvePromoteAfter(&mcpv1alpha1.BlueGreenStrategy{PromoteAfter: "not-a-duration"}))
+	assert.Equal(t, 10*time.Minute, ResolvePromoteAfter(&mcpv1alpha1.BlueGreenStrategy{PromoteAfter: "10m"}))
+}
+
+func TestCanaryTargetReplicas(t *testing.T) {
+	assert.Equal(t, int32(0), CanaryTargetReplicas(4, 0))
+	assert.Equal(t, int32(1), CanaryTargetReplicas(4, 10))
+	assert.Equal(t, int32(2), CanaryTargetReplicas(4, 50))
+	assert.Equal(t, int32(4), CanaryTargetReplicas(4, 100))
+}
+
+func ptrIntOrString(v intstr.IntOrString) *intstr.IntOrString {
+	return &v
+}